@@ -0,0 +1,7 @@
+data "ansible-forms_awx_instances" "all" {
+  cx_profile_name = "cluster1"
+}
+
+output "awx_instances" {
+  value = { for instance in data.ansible-forms_awx_instances.all.instances : instance.name => instance.uri }
+}
@@ -0,0 +1,4 @@
+data "ansible-forms_credential" "ontap" {
+  cx_profile_name = "cluster1"
+  name            = "ontap_cred"
+}
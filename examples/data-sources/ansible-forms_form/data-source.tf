@@ -0,0 +1,8 @@
+data "ansible-forms_form" "create_share" {
+  cx_profile_name = "cluster1"
+  name            = "Create share"
+}
+
+output "required_fields" {
+  value = [for field in data.ansible-forms_form.create_share.fields : field.name if field.required]
+}
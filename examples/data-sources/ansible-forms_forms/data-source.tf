@@ -0,0 +1,8 @@
+data "ansible-forms_forms" "storage" {
+  cx_profile_name = "cluster1"
+  category        = "Storage"
+}
+
+output "storage_forms" {
+  value = [for form in data.ansible-forms_forms.storage.forms : form.name]
+}
@@ -0,0 +1,4 @@
+data "ansible-forms_group" "admins" {
+  cx_profile_name = "cluster1"
+  name            = "admins"
+}
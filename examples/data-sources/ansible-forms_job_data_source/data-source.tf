@@ -2,3 +2,8 @@ data "ansible-forms_job_data_source" "job" {
   cx_profile_name = "cluster1"
   id              = 50
 }
+
+data "ansible-forms_job_data_source" "latest" {
+  cx_profile_name = "cluster1"
+  form_name       = "Demo Form Ansible No input"
+}
@@ -0,0 +1,15 @@
+resource "ansible-forms_job_resource" "job" {
+  cx_profile_name     = "cluster1"
+  form_name           = "Demo Form Ansible No input"
+  wait_for_completion = false
+}
+
+data "ansible-forms_job_wait" "job" {
+  cx_profile_name = "cluster1"
+  id              = ansible-forms_job_resource.job.id
+  timeout         = 7200
+}
+
+output "job_status" {
+  value = data.ansible-forms_job_wait.job.status
+}
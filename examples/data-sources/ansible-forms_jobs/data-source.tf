@@ -0,0 +1,6 @@
+data "ansible-forms_jobs" "last_success" {
+  cx_profile_name = "cluster1"
+  form_name       = "Demo Form Ansible No input"
+  status          = "success"
+  limit           = 1
+}
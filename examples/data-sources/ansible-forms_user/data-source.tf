@@ -0,0 +1,4 @@
+data "ansible-forms_user" "jdoe" {
+  cx_profile_name = "cluster1"
+  username        = "jdoe"
+}
@@ -0,0 +1,7 @@
+resource "ansible-forms_awx_instance" "awx" {
+  cx_profile_name = "cluster1"
+  name            = "awx"
+  uri             = "https://awx.example.com"
+  token           = var.awx_token
+  ca_bundle       = file("ca.pem")
+}
@@ -0,0 +1,6 @@
+resource "ansible-forms_azuread_settings" "azuread" {
+  cx_profile_name = "cluster1"
+  client_id       = "00000000-0000-0000-0000-000000000000"
+  client_secret   = var.azuread_client_secret
+  group_filter    = "^AF_"
+}
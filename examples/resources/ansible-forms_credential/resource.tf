@@ -0,0 +1,17 @@
+resource "ansible-forms_credential" "ontap" {
+  cx_profile_name     = "cluster1"
+  name                = "ontap_cred"
+  host                = "cluster1.example.com"
+  user                = "admin"
+  description         = "ONTAP cluster admin"
+  password_wo         = var.ontap_password
+  password_wo_version = 1
+}
+
+resource "ansible-forms_job_resource" "job" {
+  cx_profile_name = "cluster1"
+  form_name       = "Demo Form Ansible No input"
+  credentials = {
+    ontap_cred = ansible-forms_credential.ontap.name
+  }
+}
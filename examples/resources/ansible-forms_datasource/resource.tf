@@ -0,0 +1,13 @@
+resource "ansible-forms_datasource" "volumes" {
+  cx_profile_name = "cluster1"
+  name            = "volumes"
+  form            = "Import volumes"
+  schema_id       = ansible-forms_datasource_schema.cmdb.id
+  cron            = "0 * * * *"
+  source = jsonencode({
+    cluster = "cluster1"
+  })
+  run_triggers = {
+    schema = ansible-forms_datasource_schema.cmdb.table_definitions
+  }
+}
@@ -0,0 +1,6 @@
+resource "ansible-forms_datasource_schema" "cmdb" {
+  cx_profile_name   = "cluster1"
+  name              = "cmdb"
+  description       = "Storage inventory"
+  table_definitions = file("cmdb_tables.yaml")
+}
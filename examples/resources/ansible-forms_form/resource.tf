@@ -0,0 +1,27 @@
+resource "ansible-forms_form" "create_share" {
+  cx_profile_name = "cluster1"
+  name            = "Create share"
+  type            = "ansible"
+  playbook        = "create_share.yaml"
+  description     = "Create a CIFS share"
+  categories      = ["Storage"]
+  roles           = ["admin", "storage"]
+  approval = {
+    title = "Share creation"
+    roles = ["admin"]
+  }
+  fields = jsonencode([
+    {
+      name     = "share_name"
+      type     = "text"
+      label    = "Share name"
+      required = true
+    },
+    {
+      name    = "size"
+      type    = "number"
+      label   = "Size in GB"
+      default = 10
+    },
+  ])
+}
@@ -0,0 +1,4 @@
+resource "ansible-forms_group" "storage" {
+  cx_profile_name = "cluster1"
+  name            = "storage"
+}
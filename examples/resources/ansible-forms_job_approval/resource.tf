@@ -0,0 +1,17 @@
+resource "ansible-forms_job_resource" "job" {
+  cx_profile_name = "cluster1"
+  form_name       = "Demo Form Ansible With Approval"
+  extravars = {
+    region = "myregion"
+  }
+}
+
+resource "ansible-forms_job_approval" "approval" {
+  cx_profile_name = "cluster1"
+  job_id          = ansible-forms_job_resource.job.id
+  decision        = "approve"
+}
+
+output "ansible-forms_job_approval" {
+  value = ansible-forms_job_approval.approval
+}
@@ -0,0 +1,24 @@
+locals {
+  shares = {
+    finance = { size = "100", svm_name = "svm1" }
+    hr      = { size = "50", svm_name = "svm1" }
+    legal   = { size = "20", svm_name = "svm2" }
+  }
+}
+
+resource "ansible-forms_job_batch" "shares" {
+  cx_profile_name = "cluster1"
+  form_name       = "Create share"
+  items = { for name, share in local.shares : name => merge(share, {
+    share_name = name
+  }) }
+  credentials = {
+    ontap_cred = "ontap_cred"
+  }
+  max_parallel          = 10
+  stop_on_first_failure = false
+}
+
+output "share_jobs" {
+  value = { for name, job in ansible-forms_job_batch.shares.jobs : name => job.status }
+}
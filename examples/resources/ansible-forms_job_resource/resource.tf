@@ -21,6 +21,28 @@ resource "ansible-forms_job_resource" "job" {
   }
 }
 
+variable "svm_admin_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "ansible-forms_job_resource" "svm_admin" {
+  cx_profile_name = "cluster1"
+  form_name       = "Demo Form Ansible No input"
+  extravars = {
+    svm_name = "mysvm_name"
+  }
+  sensitive_extravars = {
+    api_token = "mytoken"
+  }
+  # never stored in the state, requires Terraform 1.11 or later
+  extravars_wo = {
+    admin_password = var.svm_admin_password
+  }
+  wo_version = 1
+}
+
 output "ansible-forms_job_resource" {
   value = ansible-forms_job_resource.job
 }
@@ -0,0 +1,12 @@
+# key scanned by Ansible Forms
+resource "ansible-forms_known_host" "scanned" {
+  cx_profile_name = "cluster1"
+  host            = "server1.example.com"
+}
+
+# explicit key
+resource "ansible-forms_known_host" "pinned" {
+  cx_profile_name = "cluster1"
+  host            = "server2.example.com"
+  key             = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+}
@@ -0,0 +1,11 @@
+resource "ansible-forms_ldap_settings" "ldap" {
+  cx_profile_name    = "cluster1"
+  server             = "ldap.example.com"
+  port               = 636
+  enable_tls         = true
+  ca_bundle          = file("ca.pem")
+  bind_user_dn       = "cn=ansibleforms,ou=services,dc=example,dc=com"
+  bind_user_password = var.ldap_bind_password
+  search_base        = "ou=users,dc=example,dc=com"
+  test_connection    = true
+}
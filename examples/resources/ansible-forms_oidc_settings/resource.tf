@@ -0,0 +1,6 @@
+resource "ansible-forms_oidc_settings" "oidc" {
+  cx_profile_name = "cluster1"
+  issuer          = "https://login.example.com/realms/main"
+  client_id       = "ansibleforms"
+  client_secret   = var.oidc_client_secret
+}
@@ -0,0 +1,16 @@
+resource "ansible-forms_repository" "playbooks" {
+  cx_profile_name   = "cluster1"
+  name              = "playbooks"
+  uri               = "https://git.example.com/automation/playbooks.git"
+  branch            = "main"
+  credential        = ansible-forms_credential.git.name
+  cron              = "*/15 * * * *"
+  use_for_playbooks = true
+  pull_triggers = {
+    release = "v1.2.0"
+  }
+}
+
+output "playbooks_commit" {
+  value = ansible-forms_repository.playbooks.commit
+}
@@ -0,0 +1,11 @@
+resource "ansible-forms_settings" "settings" {
+  cx_profile_name = "cluster1"
+  url             = "https://ansibleforms.example.com"
+  mail_server     = "smtp.example.com"
+  mail_port       = 587
+  mail_secure     = true
+  mail_username   = "ansibleforms"
+  mail_password   = var.smtp_password
+  mail_from       = "ansibleforms@example.com"
+  test_mail_to    = "ops@example.com"
+}
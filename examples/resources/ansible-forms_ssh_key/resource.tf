@@ -0,0 +1,5 @@
+resource "ansible-forms_ssh_key" "key" {
+  cx_profile_name        = "cluster1"
+  private_key_wo         = file("~/.ssh/ansible_forms")
+  private_key_wo_version = 1
+}
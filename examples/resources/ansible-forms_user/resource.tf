@@ -0,0 +1,8 @@
+resource "ansible-forms_user" "jdoe" {
+  cx_profile_name     = "cluster1"
+  username            = "jdoe"
+  email               = "jdoe@example.com"
+  group_id            = ansible-forms_group.storage.id
+  password_wo         = var.jdoe_password
+  password_wo_version = 1
+}
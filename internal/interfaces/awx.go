@@ -0,0 +1,151 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// AWXInstanceGetDataModel describes an AWX instance as returned by Ansible Forms, secrets are never returned.
+type AWXInstanceGetDataModel struct {
+	ID             int64  `mapstructure:"id"`
+	Name           string `mapstructure:"name"`
+	URI            string `mapstructure:"uri"`
+	Username       string `mapstructure:"username"`
+	UseCredentials bool   `mapstructure:"use_credentials"`
+	IgnoreCerts    bool   `mapstructure:"ignore_certs"`
+	CABundle       string `mapstructure:"ca_bundle"`
+}
+
+// AWXInstanceResourceBodyDataModel describes the body to create, update or check an AWX instance.
+type AWXInstanceResourceBodyDataModel struct {
+	Name           string `mapstructure:"name"`
+	URI            string `mapstructure:"uri"`
+	Token          string `mapstructure:"token,omitempty"`
+	Username       string `mapstructure:"username"`
+	Password       string `mapstructure:"password,omitempty"`
+	UseCredentials bool   `mapstructure:"use_credentials"`
+	IgnoreCerts    bool   `mapstructure:"ignore_certs"`
+	CABundle       string `mapstructure:"ca_bundle"`
+}
+
+// GetAWXInstanceByID gets an AWX instance by ID, nil is returned if it does not exist.
+func GetAWXInstanceByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) (*AWXInstanceGetDataModel, error) {
+	statusCode, response, err := r.GetNilOrOneRecord(fmt.Sprintf("awx/%d", id), nil, nil)
+	if statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading AWX instance info", fmt.Sprintf("error on GET awx/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	var instance AWXInstanceGetDataModel
+	found, err := decodeData(response, &instance)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET awx", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	if !found || instance.ID == 0 {
+		return nil, nil
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("read AWX instance info: %#v", instance))
+
+	return &instance, nil
+}
+
+// ListAWXInstances lists the AWX instances.
+func ListAWXInstances(errorHandler *utils.ErrorHandler, r restclient.RestClient) ([]AWXInstanceGetDataModel, error) {
+	statusCode, response, err := r.GetZeroOrMoreRecords("awx", nil, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading AWX instances", fmt.Sprintf("error on GET awx: %s, statusCode %d", err, statusCode))
+	}
+	if len(response) == 0 {
+		return nil, nil
+	}
+
+	var instances []AWXInstanceGetDataModel
+	if _, err = decodeData(response[0], &instances); err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET awx", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+
+	return instances, nil
+}
+
+// GetAWXInstanceByName gets an AWX instance by name, nil is returned if it does not exist.
+func GetAWXInstanceByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*AWXInstanceGetDataModel, error) {
+	instances, err := ListAWXInstances(errorHandler, r)
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		if instance.Name == name {
+			return &instance, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CheckAWXInstance asks Ansible Forms to connect to the AWX instance described by data.
+func CheckAWXInstance(errorHandler *utils.ErrorHandler, r restclient.RestClient, data AWXInstanceResourceBodyDataModel) error {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding AWX instance body", fmt.Sprintf("error on encoding POST awx/check body: %s", err))
+	}
+	statusCode, response, err := r.CallCreateMethod("awx/check", nil, body)
+	if err == nil && response.NumRecords > 0 && response.Records[0]["status"] == restclient.AnsibleStatusFailure {
+		err = fmt.Errorf("%v %v", response.Records[0]["message"], response.Records[0]["data"])
+	}
+	if err != nil {
+		return errorHandler.MakeAndReportError("AWX connection test failed", fmt.Sprintf("error on POST awx/check for %s: %s, statusCode %d", data.URI, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("AWX connection test succeeded for %s", data.URI))
+
+	return nil
+}
+
+// CreateAWXInstance creates an AWX instance and returns its ID.
+func CreateAWXInstance(errorHandler *utils.ErrorHandler, r restclient.RestClient, data AWXInstanceResourceBodyDataModel) (int64, error) {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return 0, errorHandler.MakeAndReportError("error encoding AWX instance body", fmt.Sprintf("error on encoding POST awx body: %s", err))
+	}
+	statusCode, response, err := r.CallCreateMethod("awx", nil, body)
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("error creating AWX instance", fmt.Sprintf("error on POST awx: %s, statusCode %d", err, statusCode))
+	}
+	id, err := decodeCreatedID(response)
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("failed to decode response from POST awx", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+
+	return id, nil
+}
+
+// UpdateAWXInstance updates an AWX instance, the token and password are kept when empty.
+func UpdateAWXInstance(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64, data AWXInstanceResourceBodyDataModel) error {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding AWX instance body", fmt.Sprintf("error on encoding PUT awx body: %s", err))
+	}
+	statusCode, _, err := r.CallUpdateMethod(fmt.Sprintf("awx/%d", id), nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating AWX instance", fmt.Sprintf("error on PUT awx/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
+
+// DeleteAWXInstance deletes an AWX instance.
+func DeleteAWXInstance(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) error {
+	statusCode, _, err := r.CallDeleteMethod(fmt.Sprintf("awx/%d", id), nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting AWX instance", fmt.Sprintf("error on DELETE awx/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
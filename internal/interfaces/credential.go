@@ -0,0 +1,120 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// CredentialGetDataModel describes a credential as returned by Ansible Forms, the secret is never returned.
+type CredentialGetDataModel struct {
+	ID          int64  `mapstructure:"id"`
+	Name        string `mapstructure:"name"`
+	User        string `mapstructure:"user"`
+	Host        string `mapstructure:"host"`
+	Port        int64  `mapstructure:"port"`
+	Description string `mapstructure:"description"`
+}
+
+// CredentialResourceBodyDataModel describes the body to create or update a credential.
+type CredentialResourceBodyDataModel struct {
+	Name        string `mapstructure:"name"`
+	User        string `mapstructure:"user"`
+	Host        string `mapstructure:"host"`
+	Port        int64  `mapstructure:"port,omitempty"`
+	Description string `mapstructure:"description"`
+	Password    string `mapstructure:"password,omitempty"`
+}
+
+// GetCredentialByID gets a credential by ID, nil is returned if it does not exist.
+func GetCredentialByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) (*CredentialGetDataModel, error) {
+	statusCode, response, err := r.GetNilOrOneRecord(fmt.Sprintf("credential/%d", id), nil, nil)
+	if statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading credential info", fmt.Sprintf("error on GET credential/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	var credential CredentialGetDataModel
+	found, err := decodeData(response, &credential)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET credential", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	if !found || credential.ID == 0 {
+		return nil, nil
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("read credential info: %#v", credential))
+
+	return &credential, nil
+}
+
+// GetCredentialByName gets a credential by name, nil is returned if it does not exist.
+func GetCredentialByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*CredentialGetDataModel, error) {
+	statusCode, response, err := r.GetZeroOrMoreRecords("credential", nil, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading credentials", fmt.Sprintf("error on GET credential: %s, statusCode %d", err, statusCode))
+	}
+	if len(response) == 0 {
+		return nil, nil
+	}
+
+	var credentials []CredentialGetDataModel
+	if _, err = decodeData(response[0], &credentials); err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET credential", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	for _, credential := range credentials {
+		if credential.Name == name {
+			return &credential, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateCredential creates a credential and returns its ID.
+func CreateCredential(errorHandler *utils.ErrorHandler, r restclient.RestClient, data CredentialResourceBodyDataModel) (int64, error) {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return 0, errorHandler.MakeAndReportError("error encoding credential body", fmt.Sprintf("error on encoding POST credential body: %s", err))
+	}
+	statusCode, response, err := r.CallCreateMethod("credential", nil, body)
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("error creating credential", fmt.Sprintf("error on POST credential: %s, statusCode %d", err, statusCode))
+	}
+	id, err := decodeCreatedID(response)
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("failed to decode response from POST credential", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+
+	return id, nil
+}
+
+// UpdateCredential updates a credential, the password is kept when empty.
+func UpdateCredential(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64, data CredentialResourceBodyDataModel) error {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding credential body", fmt.Sprintf("error on encoding PUT credential body: %s", err))
+	}
+	statusCode, _, err := r.CallUpdateMethod(fmt.Sprintf("credential/%d", id), nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating credential", fmt.Sprintf("error on PUT credential/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
+
+// DeleteCredential deletes a credential.
+func DeleteCredential(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) error {
+	statusCode, _, err := r.CallDeleteMethod(fmt.Sprintf("credential/%d", id), nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting credential", fmt.Sprintf("error on DELETE credential/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// DatasourceSchemaDataModel describes a datasource schema, the MySQL tables a datasource imports into.
+type DatasourceSchemaDataModel struct {
+	ID               int64  `mapstructure:"id,omitempty"`
+	Name             string `mapstructure:"name"`
+	Description      string `mapstructure:"description"`
+	TableDefinitions string `mapstructure:"table_definitions"`
+}
+
+// DatasourceDataModel describes a datasource, a form run to import data into the tables of a schema.
+type DatasourceDataModel struct {
+	ID          int64  `mapstructure:"id,omitempty"`
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	Form        string `mapstructure:"form"`
+	SchemaID    int64  `mapstructure:"schema_id"`
+	Cron        string `mapstructure:"cron"`
+	Source      string `mapstructure:"source"`
+}
+
+// GetDatasourceSchemaByID gets a datasource schema by ID, nil is returned if it does not exist.
+func GetDatasourceSchemaByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) (*DatasourceSchemaDataModel, error) {
+	statusCode, response, err := r.GetNilOrOneRecord(fmt.Sprintf("datasource/schema/%d", id), nil, nil)
+	if statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading datasource schema info", fmt.Sprintf("error on GET datasource/schema/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	var schema DatasourceSchemaDataModel
+	found, err := decodeData(response, &schema)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET datasource/schema", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	if !found || schema.ID == 0 {
+		return nil, nil
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("read datasource schema info: %#v", schema))
+
+	return &schema, nil
+}
+
+// GetDatasourceSchemaByName gets a datasource schema by name, nil is returned if it does not exist.
+func GetDatasourceSchemaByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*DatasourceSchemaDataModel, error) {
+	statusCode, response, err := r.GetZeroOrMoreRecords("datasource/schema", nil, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading datasource schemas", fmt.Sprintf("error on GET datasource/schema: %s, statusCode %d", err, statusCode))
+	}
+	if len(response) == 0 {
+		return nil, nil
+	}
+
+	var schemas []DatasourceSchemaDataModel
+	if _, err = decodeData(response[0], &schemas); err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET datasource/schema", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	for _, schema := range schemas {
+		if schema.Name == name {
+			return &schema, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateDatasourceSchema creates a datasource schema and returns its ID.
+func CreateDatasourceSchema(errorHandler *utils.ErrorHandler, r restclient.RestClient, data DatasourceSchemaDataModel) (int64, error) {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return 0, errorHandler.MakeAndReportError("error encoding datasource schema body", fmt.Sprintf("error on encoding POST datasource/schema body: %s", err))
+	}
+	statusCode, response, err := r.CallCreateMethod("datasource/schema", nil, body)
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("error creating datasource schema", fmt.Sprintf("error on POST datasource/schema: %s, statusCode %d", err, statusCode))
+	}
+	id, err := decodeCreatedID(response)
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("failed to decode response from POST datasource/schema", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+
+	return id, nil
+}
+
+// UpdateDatasourceSchema updates a datasource schema.
+func UpdateDatasourceSchema(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64, data DatasourceSchemaDataModel) error {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding datasource schema body", fmt.Sprintf("error on encoding PUT datasource/schema body: %s", err))
+	}
+	statusCode, _, err := r.CallUpdateMethod(fmt.Sprintf("datasource/schema/%d", id), nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating datasource schema", fmt.Sprintf("error on PUT datasource/schema/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
+
+// DeleteDatasourceSchema deletes a datasource schema.
+func DeleteDatasourceSchema(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) error {
+	statusCode, _, err := r.CallDeleteMethod(fmt.Sprintf("datasource/schema/%d", id), nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting datasource schema", fmt.Sprintf("error on DELETE datasource/schema/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
+
+// GetDatasourceByID gets a datasource by ID, nil is returned if it does not exist.
+func GetDatasourceByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) (*DatasourceDataModel, error) {
+	statusCode, response, err := r.GetNilOrOneRecord(fmt.Sprintf("datasource/%d", id), nil, nil)
+	if statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading datasource info", fmt.Sprintf("error on GET datasource/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	var datasource DatasourceDataModel
+	found, err := decodeData(response, &datasource)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET datasource", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	if !found || datasource.ID == 0 {
+		return nil, nil
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("read datasource info: %#v", datasource))
+
+	return &datasource, nil
+}
+
+// GetDatasourceByName gets a datasource by name, nil is returned if it does not exist.
+func GetDatasourceByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*DatasourceDataModel, error) {
+	statusCode, response, err := r.GetZeroOrMoreRecords("datasource", nil, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading datasources", fmt.Sprintf("error on GET datasource: %s, statusCode %d", err, statusCode))
+	}
+	if len(response) == 0 {
+		return nil, nil
+	}
+
+	var datasources []DatasourceDataModel
+	if _, err = decodeData(response[0], &datasources); err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET datasource", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	for _, datasource := range datasources {
+		if datasource.Name == name {
+			return &datasource, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateDatasource creates a datasource and returns its ID.
+func CreateDatasource(errorHandler *utils.ErrorHandler, r restclient.RestClient, data DatasourceDataModel) (int64, error) {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return 0, errorHandler.MakeAndReportError("error encoding datasource body", fmt.Sprintf("error on encoding POST datasource body: %s", err))
+	}
+	statusCode, response, err := r.CallCreateMethod("datasource", nil, body)
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("error creating datasource", fmt.Sprintf("error on POST datasource: %s, statusCode %d", err, statusCode))
+	}
+	id, err := decodeCreatedID(response)
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("failed to decode response from POST datasource", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+
+	return id, nil
+}
+
+// UpdateDatasource updates a datasource.
+func UpdateDatasource(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64, data DatasourceDataModel) error {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding datasource body", fmt.Sprintf("error on encoding PUT datasource body: %s", err))
+	}
+	statusCode, _, err := r.CallUpdateMethod(fmt.Sprintf("datasource/%d", id), nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating datasource", fmt.Sprintf("error on PUT datasource/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
+
+// DeleteDatasource deletes a datasource.
+func DeleteDatasource(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) error {
+	statusCode, _, err := r.CallDeleteMethod(fmt.Sprintf("datasource/%d", id), nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting datasource", fmt.Sprintf("error on DELETE datasource/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
+
+// RunDatasource starts the import of a datasource and waits for its job to complete.
+func RunDatasource(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64, options restclient.JobWaitOptions) (*JobGetDataSourceModel, error) {
+	statusCode, response, err := r.CallCreateMethod(fmt.Sprintf("datasource/%d/import", id), nil, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error running datasource", fmt.Sprintf("error on POST datasource/%d/import: %s, statusCode %d", id, err, statusCode))
+	}
+	jobID, err := decodeCreatedID(response)
+	if err == nil && jobID == 0 {
+		err = fmt.Errorf("no job ID in response")
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from POST datasource/import", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("datasource %d import started as job %d", id, jobID))
+
+	return WaitForJob(errorHandler, r, jobID, options)
+}
@@ -0,0 +1,231 @@
+package interfaces
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// FormTypes are the types of forms supported by Ansible Forms.
+var FormTypes = []string{"ansible", "awx", "multistep"}
+
+// FormApprovalModel describes the approval required to run a form.
+type FormApprovalModel struct {
+	Title         string   `mapstructure:"title"`
+	Message       string   `mapstructure:"message"`
+	Roles         []string `mapstructure:"roles"`
+	Notifications []string `mapstructure:"notifications"`
+}
+
+// FormDataModel describes a form of the forms configuration.
+type FormDataModel struct {
+	Name        string             `mapstructure:"name"`
+	Type        string             `mapstructure:"type"`
+	Playbook    string             `mapstructure:"playbook"`
+	Template    string             `mapstructure:"template"`
+	Description string             `mapstructure:"description"`
+	Categories  []string           `mapstructure:"categories"`
+	Roles       []string           `mapstructure:"roles"`
+	Approval    *FormApprovalModel `mapstructure:"approval"`
+	Fields      []map[string]any   `mapstructure:"fields"`
+}
+
+// FormFieldDataModel describes a field of a form, keys not listed are only available in the raw fields.
+type FormFieldDataModel struct {
+	Name        string `mapstructure:"name"`
+	Type        string `mapstructure:"type"`
+	Label       string `mapstructure:"label"`
+	Description string `mapstructure:"description"`
+	Required    bool   `mapstructure:"required"`
+	Default     any    `mapstructure:"default"`
+	Values      any    `mapstructure:"values"`
+}
+
+// FormCategoryDataModel describes a category of the forms configuration.
+type FormCategoryDataModel struct {
+	Name string `mapstructure:"name"`
+	Icon string `mapstructure:"icon"`
+}
+
+// FormRoleDataModel describes a role of the forms configuration.
+type FormRoleDataModel struct {
+	Name   string   `mapstructure:"name"`
+	Groups []string `mapstructure:"groups"`
+	Users  []string `mapstructure:"users"`
+}
+
+// FormConfig is the forms configuration of Ansible Forms (forms.yaml).
+// Keys not managed by the provider are kept when the configuration is saved.
+type FormConfig struct {
+	raw map[string]any
+}
+
+// formConfigMutex serializes changes to the forms configuration, as each change rewrites the whole configuration.
+var formConfigMutex sync.Mutex
+
+// GetFormConfig gets the forms configuration.
+func GetFormConfig(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*FormConfig, error) {
+	statusCode, response, err := r.GetNilOrOneRecord("config", nil, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET config")
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading forms configuration", fmt.Sprintf("error on GET config: %s, statusCode %d", err, statusCode))
+	}
+
+	var raw map[string]any
+	if _, err = decodeData(response, &raw); err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET config", fmt.Sprintf("error: %s, statusCode %d", err, statusCode))
+	}
+	if raw == nil {
+		raw = map[string]any{}
+	}
+
+	return &FormConfig{raw: raw}, nil
+}
+
+// UpdateFormConfig reads the forms configuration, applies update and saves it.
+func UpdateFormConfig(errorHandler *utils.ErrorHandler, r restclient.RestClient, update func(*FormConfig) error) error {
+	formConfigMutex.Lock()
+	defer formConfigMutex.Unlock()
+
+	config, err := GetFormConfig(errorHandler, r)
+	if err != nil {
+		return err
+	}
+	if err = update(config); err != nil {
+		return errorHandler.MakeAndReportError("error updating forms configuration", err.Error())
+	}
+	statusCode, _, err := r.CallUpdateMethod("config", nil, config.raw)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error saving forms configuration", fmt.Sprintf("error on PUT config: %s, statusCode %d", err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, "saved forms configuration")
+
+	return nil
+}
+
+// list returns the objects stored under key.
+func (c *FormConfig) list(key string) []any {
+	list, _ := c.raw[key].([]any)
+	return list
+}
+
+// Forms returns the forms of the configuration.
+func (c *FormConfig) Forms() ([]FormDataModel, error) {
+	var forms []FormDataModel
+	if err := mapstructure.WeakDecode(c.list("forms"), &forms); err != nil {
+		return nil, fmt.Errorf("failed to decode forms: %s", err)
+	}
+
+	return forms, nil
+}
+
+// Form returns a form by name, nil if it does not exist.
+func (c *FormConfig) Form(name string) (*FormDataModel, error) {
+	raw := c.RawForm(name)
+	if raw == nil {
+		return nil, nil
+	}
+	var form FormDataModel
+	if err := mapstructure.WeakDecode(raw, &form); err != nil {
+		return nil, fmt.Errorf("failed to decode form %s: %s", name, err)
+	}
+
+	return &form, nil
+}
+
+// RawForm returns a form by name with all its keys, nil if it does not exist.
+func (c *FormConfig) RawForm(name string) map[string]any {
+	for _, f := range c.list("forms") {
+		if form, ok := f.(map[string]any); ok && form["name"] == name {
+			return form
+		}
+	}
+
+	return nil
+}
+
+// SetForm adds or replaces the form named name.
+func (c *FormConfig) SetForm(name string, form map[string]any) {
+	forms := c.list("forms")
+	for index, f := range forms {
+		if existing, ok := f.(map[string]any); ok && existing["name"] == name {
+			forms[index] = form
+			return
+		}
+	}
+	c.raw["forms"] = append(forms, form)
+}
+
+// RemoveForm removes the form named name.
+func (c *FormConfig) RemoveForm(name string) {
+	forms := c.list("forms")
+	kept := make([]any, 0, len(forms))
+	for _, f := range forms {
+		if form, ok := f.(map[string]any); ok && form["name"] == name {
+			continue
+		}
+		kept = append(kept, f)
+	}
+	c.raw["forms"] = kept
+}
+
+// FormFields returns the fields of the form.
+func (f *FormDataModel) FormFields() ([]FormFieldDataModel, error) {
+	var fields []FormFieldDataModel
+	if err := mapstructure.WeakDecode(f.Fields, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode fields of form %s: %s", f.Name, err)
+	}
+
+	return fields, nil
+}
+
+// Categories returns the categories of the configuration.
+func (c *FormConfig) Categories() ([]FormCategoryDataModel, error) {
+	var categories []FormCategoryDataModel
+	if err := mapstructure.WeakDecode(c.list("categories"), &categories); err != nil {
+		return nil, fmt.Errorf("failed to decode categories: %s", err)
+	}
+
+	return categories, nil
+}
+
+// Roles returns the roles of the configuration.
+func (c *FormConfig) Roles() ([]FormRoleDataModel, error) {
+	var roles []FormRoleDataModel
+	if err := mapstructure.WeakDecode(c.list("roles"), &roles); err != nil {
+		return nil, fmt.Errorf("failed to decode roles: %s", err)
+	}
+
+	return roles, nil
+}
+
+// ValidateFormFields checks the fields of a form: each field is an object with a unique name and a type.
+func ValidateFormFields(fields []any) error {
+	names := make(map[string]bool, len(fields))
+	for index, f := range fields {
+		field, ok := f.(map[string]any)
+		if !ok {
+			return fmt.Errorf("field %d is not an object", index)
+		}
+		name, _ := field["name"].(string)
+		if name == "" {
+			return fmt.Errorf("field %d has no name", index)
+		}
+		if names[name] {
+			return fmt.Errorf("field %s is defined more than once", name)
+		}
+		names[name] = true
+		if fieldType, _ := field["type"].(string); fieldType == "" {
+			return fmt.Errorf("field %s has no type", name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// GroupGetDataModel describes a local group as returned by Ansible Forms.
+type GroupGetDataModel struct {
+	ID   int64  `mapstructure:"id"`
+	Name string `mapstructure:"name"`
+}
+
+// GroupResourceBodyDataModel describes the body to create or update a local group.
+type GroupResourceBodyDataModel struct {
+	Name string `mapstructure:"name"`
+}
+
+// GetGroupByID gets a local group by ID, nil is returned if it does not exist.
+func GetGroupByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) (*GroupGetDataModel, error) {
+	statusCode, response, err := r.GetNilOrOneRecord(fmt.Sprintf("group/%d", id), nil, nil)
+	if statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading group info", fmt.Sprintf("error on GET group/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	var group GroupGetDataModel
+	found, err := decodeData(response, &group)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET group", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	if !found || group.ID == 0 {
+		return nil, nil
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("read group info: %#v", group))
+
+	return &group, nil
+}
+
+// GetGroupByName gets a local group by name, nil is returned if it does not exist.
+func GetGroupByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*GroupGetDataModel, error) {
+	statusCode, response, err := r.GetZeroOrMoreRecords("group", nil, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading groups", fmt.Sprintf("error on GET group: %s, statusCode %d", err, statusCode))
+	}
+	if len(response) == 0 {
+		return nil, nil
+	}
+
+	var groups []GroupGetDataModel
+	if _, err = decodeData(response[0], &groups); err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET group", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	for _, group := range groups {
+		if group.Name == name {
+			return &group, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateGroup creates a local group and returns its ID.
+func CreateGroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, data GroupResourceBodyDataModel) (int64, error) {
+	body := map[string]any{"name": data.Name}
+	statusCode, response, err := r.CallCreateMethod("group", nil, body)
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("error creating group", fmt.Sprintf("error on POST group: %s, statusCode %d", err, statusCode))
+	}
+	id, err := decodeCreatedID(response)
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("failed to decode response from POST group", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+
+	return id, nil
+}
+
+// UpdateGroup updates a local group.
+func UpdateGroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64, data GroupResourceBodyDataModel) error {
+	body := map[string]any{"name": data.Name}
+	statusCode, _, err := r.CallUpdateMethod(fmt.Sprintf("group/%d", id), nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating group", fmt.Sprintf("error on PUT group/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
+
+// DeleteGroup deletes a local group, Ansible Forms refuses to delete a group that still has users.
+func DeleteGroup(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) error {
+	statusCode, _, err := r.CallDeleteMethod(fmt.Sprintf("group/%d", id), nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting group", fmt.Sprintf("error on DELETE group/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
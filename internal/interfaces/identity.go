@@ -0,0 +1,143 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// LDAPSettingsDataModel describes the LDAP settings of Ansible Forms.
+type LDAPSettingsDataModel struct {
+	Enable                   bool   `mapstructure:"enable"`
+	Server                   string `mapstructure:"server"`
+	Port                     int64  `mapstructure:"port"`
+	IgnoreCerts              bool   `mapstructure:"ignore_certs"`
+	EnableTLS                bool   `mapstructure:"enable_tls"`
+	Cert                     string `mapstructure:"cert"`
+	CABundle                 string `mapstructure:"ca_bundle"`
+	BindUserDN               string `mapstructure:"bind_user_dn"`
+	BindUserPassword         string `mapstructure:"bind_user_pw"`
+	SearchBase               string `mapstructure:"search_base"`
+	UsernameAttribute        string `mapstructure:"username_attribute"`
+	GroupsAttribute          string `mapstructure:"groups_attribute"`
+	GroupsSearchBase         string `mapstructure:"groups_search_base"`
+	GroupClass               string `mapstructure:"group_class"`
+	GroupMemberAttribute     string `mapstructure:"group_member_attribute"`
+	GroupMemberUserAttribute string `mapstructure:"group_member_user_attribute"`
+	MailAttribute            string `mapstructure:"mail_attribute"`
+}
+
+// AzureADSettingsDataModel describes the Azure AD settings of Ansible Forms.
+type AzureADSettingsDataModel struct {
+	Enable       bool   `mapstructure:"enable"`
+	ClientID     string `mapstructure:"client_id"`
+	ClientSecret string `mapstructure:"secret_id"`
+	GroupFilter  string `mapstructure:"groupfilter"`
+}
+
+// OIDCSettingsDataModel describes the OIDC settings of Ansible Forms.
+type OIDCSettingsDataModel struct {
+	Enable       bool   `mapstructure:"enabled"`
+	Issuer       string `mapstructure:"issuer"`
+	ClientID     string `mapstructure:"client_id"`
+	ClientSecret string `mapstructure:"client_secret"`
+	GroupFilter  string `mapstructure:"groupfilter"`
+}
+
+// getSettings gets the singleton settings object at api into output.
+func getSettings(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string, output any) error {
+	statusCode, response, err := r.GetNilOrOneRecord(api, nil, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET %s", api)
+	}
+	if err != nil {
+		return errorHandler.MakeAndReportError(fmt.Sprintf("error reading %s settings", api), fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+	if _, err = decodeData(response, output); err != nil {
+		return errorHandler.MakeAndReportError(fmt.Sprintf("failed to decode response from GET %s", api), fmt.Sprintf("error: %s, statusCode %d", err, statusCode))
+	}
+
+	return nil
+}
+
+// updateSettings replaces the singleton settings object at api with data.
+func updateSettings(errorHandler *utils.ErrorHandler, r restclient.RestClient, api string, data any) error {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError(fmt.Sprintf("error encoding %s body", api), fmt.Sprintf("error on encoding PUT %s body: %s", api, err))
+	}
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError(fmt.Sprintf("error updating %s settings", api), fmt.Sprintf("error on PUT %s: %s, statusCode %d", api, err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("saved %s settings", api))
+
+	return nil
+}
+
+// GetLDAPSettings gets the LDAP settings, the bind password is not returned in clear text.
+func GetLDAPSettings(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*LDAPSettingsDataModel, error) {
+	var settings LDAPSettingsDataModel
+	if err := getSettings(errorHandler, r, "ldap", &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// UpdateLDAPSettings updates the LDAP settings.
+func UpdateLDAPSettings(errorHandler *utils.ErrorHandler, r restclient.RestClient, data LDAPSettingsDataModel) error {
+	return updateSettings(errorHandler, r, "ldap", data)
+}
+
+// CheckLDAPSettings asks Ansible Forms to connect and bind to the LDAP server with the given settings.
+func CheckLDAPSettings(errorHandler *utils.ErrorHandler, r restclient.RestClient, data LDAPSettingsDataModel) error {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding ldap body", fmt.Sprintf("error on encoding POST ldap/check body: %s", err))
+	}
+	statusCode, response, err := r.CallCreateMethod("ldap/check", nil, body)
+	if err == nil && response.NumRecords > 0 && response.Records[0]["status"] == restclient.AnsibleStatusFailure {
+		err = fmt.Errorf("%v %v", response.Records[0]["message"], response.Records[0]["data"])
+	}
+	if err != nil {
+		return errorHandler.MakeAndReportError("LDAP connection test failed", fmt.Sprintf("error on POST ldap/check: %s, statusCode %d", err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, "LDAP connection test succeeded")
+
+	return nil
+}
+
+// GetAzureADSettings gets the Azure AD settings, the client secret is not returned in clear text.
+func GetAzureADSettings(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*AzureADSettingsDataModel, error) {
+	var settings AzureADSettingsDataModel
+	if err := getSettings(errorHandler, r, "azuread", &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// UpdateAzureADSettings updates the Azure AD settings.
+func UpdateAzureADSettings(errorHandler *utils.ErrorHandler, r restclient.RestClient, data AzureADSettingsDataModel) error {
+	return updateSettings(errorHandler, r, "azuread", data)
+}
+
+// GetOIDCSettings gets the OIDC settings, the client secret is not returned in clear text.
+func GetOIDCSettings(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*OIDCSettingsDataModel, error) {
+	var settings OIDCSettingsDataModel
+	if err := getSettings(errorHandler, r, "oidc", &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// UpdateOIDCSettings updates the OIDC settings.
+func UpdateOIDCSettings(errorHandler *utils.ErrorHandler, r restclient.RestClient, data OIDCSettingsDataModel) error {
+	return updateSettings(errorHandler, r, "oidc", data)
+}
@@ -1,8 +1,13 @@
 package interfaces
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mitchellh/mapstructure"
@@ -52,12 +57,6 @@ type ExtravarsBodyDataModel struct {
 	VolumeComment      string `mapstructure:"volume_comment"`
 }
 
-// CredentialsDataModel describes data model
-type CredentialsDataModel struct {
-	CifsCred  string `mapstructure:"cifs_cred"`
-	OntapCred string `mapstructure:"ontap_cred"`
-}
-
 // JobGetDataSourceModel ...
 type JobGetDataSourceModel struct {
 	ID          int64                  `mapstructure:"id"`
@@ -67,17 +66,52 @@ type JobGetDataSourceModel struct {
 	UserType    string                 `mapstructure:"user_type"`
 	JobType     string                 `mapstructure:"job_type"`
 	Extravars   map[string]interface{} `mapstructure:"extravars"`
-	Credentials CredentialsDataModel   `mapstructure:"credentials"`
+	Credentials map[string]interface{} `mapstructure:"credentials"`
 	Form        string                 `mapstructure:"formName"`
 	Status      string                 `mapstructure:"status"`
 	Target      string                 `mapstructure:"target"`
 	Output      string                 `mapstructure:"output"`
+	Counter     int64                  `mapstructure:"counter"`
+	NoOfRecords int64                  `mapstructure:"no_of_records"`
 	Data        string                 `mapstructure:"data"`
 	Approval    map[string]interface{} `mapstructure:"approval"`
 	State       string                 `mapstructure:"state"`
 	Error       string                 `mapstructure:"error"`
 }
 
+// JobApprovalModel describes the approval of a job.
+type JobApprovalModel struct {
+	Title      string   `mapstructure:"title"`
+	Message    string   `mapstructure:"message"`
+	Roles      []string `mapstructure:"roles"`
+	ApprovedBy string   `mapstructure:"approved_by"`
+	ApprovedAt string   `mapstructure:"approved_at"`
+	Decision   string   `mapstructure:"decision"`
+}
+
+// GetApproval decodes the approval of a job, nil is returned when the job does not require approval.
+func (j *JobGetDataSourceModel) GetApproval() (*JobApprovalModel, error) {
+	if len(j.Approval) == 0 {
+		return nil, nil
+	}
+	var approval JobApprovalModel
+	if err := mapstructure.WeakDecode(j.Approval, &approval); err != nil {
+		return nil, err
+	}
+	if approval.Decision == "" {
+		switch j.Status {
+		case restclient.AnsibleJobStatusApprove:
+			approval.Decision = "pending"
+		case restclient.AnsibleJobStatusRejected:
+			approval.Decision = "rejected"
+		default:
+			approval.Decision = "approved"
+		}
+	}
+
+	return &approval, nil
+}
+
 // GetJobResponse describes GET job response.
 type GetJobResponse struct {
 	Status  string                `mapstructure:"status"`
@@ -85,6 +119,22 @@ type GetJobResponse struct {
 	Data    JobGetDataSourceModel `mapstructure:"data"`
 }
 
+// ListJobsResponse describes GET jobs response.
+type ListJobsResponse struct {
+	Status  string                  `mapstructure:"status"`
+	Message string                  `mapstructure:"message"`
+	Data    []JobGetDataSourceModel `mapstructure:"data"`
+}
+
+// GetJobOutputResponse describes GET job output response.
+type GetJobOutputResponse struct {
+	Status  string `mapstructure:"status"`
+	Message string `mapstructure:"message"`
+	Data    struct {
+		Output string `mapstructure:"output"`
+	} `mapstructure:"data"`
+}
+
 // CreateJobResponse ...
 type CreateJobResponse struct {
 	Status  string `json:"status"`
@@ -97,29 +147,111 @@ type CreateJobResponse struct {
 	} `json:"data"`
 }
 
+// decodeJobs decodes jobs, extravars and credentials may be returned as JSON strings.
+func decodeJobs(input any, output any) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: jsonStringToMapHook,
+		Result:     output,
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(input)
+}
+
+// jsonStringToMapHook converts a JSON string to a map when a map is expected.
+func jsonStringToMapHook(from reflect.Type, to reflect.Type, data any) (any, error) {
+	if from.Kind() != reflect.String || to.Kind() != reflect.Map {
+		return data, nil
+	}
+	str := data.(string)
+	if str == "" {
+		return nil, nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal([]byte(str), &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
 // GetJobByID gets job info by id.
 func GetJobByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) (*JobGetDataSourceModel, error) {
-	statusCode, response, err := r.GetNilOrOneRecord(fmt.Sprintf("job/%d", id), nil, nil)
-	if err == nil && response["message"] == "failed to find job" {
-		err = fmt.Errorf("no response for GET Job by ID")
+	job, err := FindJobByID(errorHandler, r, id)
+	if err == nil && job == nil {
+		return nil, errorHandler.MakeAndReportError("error reading job info", fmt.Sprintf("error on GET job/: no response for GET Job by ID %d", id))
 	}
+
+	return job, err
+}
+
+// FindJobByID gets a job by ID, or nil when the job does not exist.
+func FindJobByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) (*JobGetDataSourceModel, error) {
+	statusCode, response, err := r.GetNilOrOneRecord(fmt.Sprintf("job/%d", id), nil, nil)
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("error reading job info", fmt.Sprintf("error on GET job/: %s, statusCode %d", err, statusCode))
 	}
+	if response == nil || response["message"] == "failed to find job" {
+		return nil, nil
+	}
 
 	var apiResp *GetJobResponse
-	if err = mapstructure.Decode(response, &apiResp); err != nil {
+	if err = decodeJobs(response, &apiResp); err != nil {
 		return nil, errorHandler.MakeAndReportError("failed to decode response from GET job", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
 	}
 	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("read job info: %#v", apiResp.Data))
 
-	apiResp.Data.Status = apiResp.Status
+	// a job waiting for or refused approval keeps its own status, the response status only tells running/success/error
+	if apiResp.Data.Status != restclient.AnsibleJobStatusApprove && apiResp.Data.Status != restclient.AnsibleJobStatusRejected {
+		apiResp.Data.Status = apiResp.Status
+	}
 
 	return &apiResp.Data, nil
 }
 
-// CreateJob creates a job.
-func CreateJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, data JobResourceModel) (*GetJobResponse, error) {
+// JobError is returned when a job was launched but did not complete successfully, it carries the ID and status of the job.
+type JobError struct {
+	ID     int64
+	Status string
+	Err    error
+}
+
+func (e *JobError) Error() string {
+	return fmt.Sprintf("error on job %d: %s, status %s", e.ID, e.Err, e.Status)
+}
+
+func (e *JobError) Unwrap() error {
+	return e.Err
+}
+
+// IsJobFailure tells whether the job itself ran and failed, as opposed to being rejected or timing out.
+func (e *JobError) IsJobFailure() bool {
+	return e.Status == restclient.AnsibleStatusFailure
+}
+
+// reportJobError reports a *JobError returned by SubmitJob or SubmitRelaunchJob, other errors are already reported.
+func reportJobError(errorHandler *utils.ErrorHandler, summary string, job *GetJobResponse, err error) (*GetJobResponse, error) {
+	var jobErr *JobError
+	if errors.As(err, &jobErr) {
+		return nil, errorHandler.MakeAndReportError(summary, jobErr.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// CreateJob creates a job and waits for its completion, see restclient.JobWaitOptions for jobs pending approval.
+func CreateJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, data JobResourceModel, options restclient.JobWaitOptions) (*GetJobResponse, error) {
+	job, err := SubmitJob(errorHandler, r, data, options)
+	return reportJobError(errorHandler, "error creating job", job, err)
+}
+
+// launchJob posts a job for the form and extravars of data.
+func launchJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, data JobResourceModel) (*CreateJobResponse, error) {
 	var body map[string]interface{}
 	if err := mapstructure.Decode(data, &body); err != nil {
 		return nil, errorHandler.MakeAndReportError("error encoding job body", fmt.Sprintf("error on encoding POST job/ body: %s, body: %#v", err, data))
@@ -141,21 +273,51 @@ func CreateJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, data J
 
 	body["credentials"] = credentialsMap
 
-	status, response, err := r.CallCreateMethod("job/", nil, body) // Ansible Forms API does not allow querying.
+	statusCode, response, err := r.CallCreateMethod("job/", nil, body) // Ansible Forms API does not allow querying.
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("error creating job", fmt.Sprintf("error on POST job/: %s, status %v", err, status))
+		return nil, errorHandler.MakeAndReportError("error creating job", fmt.Sprintf("error on POST job/: %s, statusCode %d", err, statusCode))
+	}
+	if response.NumRecords == 0 {
+		return nil, errorHandler.MakeAndReportError("error creating job", fmt.Sprintf("no response for POST job/, statusCode %d", statusCode))
 	}
 
 	var resp *CreateJobResponse
 	if err = mapstructure.Decode(response.Records[0], &resp); err != nil {
-		return nil, errorHandler.MakeAndReportError("failed to decode response from POST job/", fmt.Sprintf("error: %s, status %s, response %#v", err, status, response))
+		return nil, errorHandler.MakeAndReportError("failed to decode response from POST job/", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
 	}
-	jobData, err := GetJobByID(errorHandler, r, resp.Data.Output.ID)
+
+	return resp, nil
+}
+
+// SubmitJob creates a job and waits for its completion like CreateJob.
+// When the job was launched but did not complete successfully, the job is returned along with a *JobError which is not reported,
+// so that the caller can keep track of the job before deciding whether this is an error.
+func SubmitJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, data JobResourceModel, options restclient.JobWaitOptions) (*GetJobResponse, error) {
+	resp, err := launchJob(errorHandler, r, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return waitForJobResponse(errorHandler, r, resp, options)
+}
+
+// StartJob creates a job and returns it without waiting for its completion.
+func StartJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, data JobResourceModel) (*GetJobResponse, error) {
+	resp, err := launchJob(errorHandler, r, data)
 	if err != nil {
-		return nil, errorHandler.MakeAndReportError("failed to retrieve response from GET job/", fmt.Sprintf("error: %s, status %s, response %#v", err, status, response))
+		return nil, err
 	}
 
-	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Create svm source - udata: %#v", jobData))
+	return getJobResponse(errorHandler, r, resp)
+}
+
+// getJobResponse reads the job launched with resp.
+func getJobResponse(errorHandler *utils.ErrorHandler, r restclient.RestClient, resp *CreateJobResponse) (*GetJobResponse, error) {
+	jobData, err := GetJobByID(errorHandler, r, resp.Data.Output.ID)
+	if err != nil {
+		return nil, err
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("started job %d, status %s", jobData.ID, jobData.Status))
 
 	return &GetJobResponse{
 		Status:  resp.Status,
@@ -164,6 +326,33 @@ func CreateJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, data J
 	}, nil
 }
 
+// waitForJobResponse waits for a job launched with resp, a *JobError is returned with the job when it did not complete successfully.
+func waitForJobResponse(errorHandler *utils.ErrorHandler, r restclient.RestClient, resp *CreateJobResponse, options restclient.JobWaitOptions) (*GetJobResponse, error) {
+	id := resp.Data.Output.ID
+	status, _, waitErr := r.WaitForJob(id, options)
+
+	jobData, err := GetJobByID(errorHandler, r, id)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to retrieve response from GET job/", fmt.Sprintf("error: %s, status %s, job %d", err, status, id))
+	}
+
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("job %d - data: %#v", id, jobData))
+
+	job := &GetJobResponse{
+		Status:  resp.Status,
+		Message: resp.Message,
+		Data:    *jobData,
+	}
+	if waitErr != nil {
+		if job.Data.Error == "" {
+			job.Data.Error = waitErr.Error()
+		}
+		return job, &JobError{ID: id, Status: status, Err: waitErr}
+	}
+
+	return job, nil
+}
+
 // DeleteJobByID deletes a job by ID.
 func DeleteJobByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) error {
 	statusCode, _, err := r.CallDeleteMethod(fmt.Sprintf("job/%d", id), nil, nil)
@@ -173,3 +362,287 @@ func DeleteJobByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, id
 
 	return nil
 }
+
+// ApproveJob approves a job pending approval.
+func ApproveJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) error {
+	statusCode, _, err := r.CallCreateMethod(fmt.Sprintf("job/%d/approve", id), nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error approving job", fmt.Sprintf("error on POST job/%d/approve: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
+
+// RejectJob rejects a job pending approval.
+func RejectJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) error {
+	statusCode, _, err := r.CallCreateMethod(fmt.Sprintf("job/%d/reject", id), nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error rejecting job", fmt.Sprintf("error on POST job/%d/reject: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
+
+// WaitForJob waits for a job to complete and returns the job.
+func WaitForJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64, options restclient.JobWaitOptions) (*JobGetDataSourceModel, error) {
+	status, _, err := r.WaitForJob(id, options)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error waiting for job", fmt.Sprintf("error on job %d: %s, status %s", id, err, status))
+	}
+
+	return GetJobByID(errorHandler, r, id)
+}
+
+// AwaitJob waits for a job like WaitForJob, a job that did not complete successfully is returned along with a *JobError like SubmitJob.
+func AwaitJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64, options restclient.JobWaitOptions) (*JobGetDataSourceModel, error) {
+	resp := &CreateJobResponse{}
+	resp.Data.Output.ID = id
+	job, err := waitForJobResponse(errorHandler, r, resp, options)
+	if job == nil {
+		return nil, err
+	}
+
+	return &job.Data, err
+}
+
+// RelaunchJob relaunches a job with its original extravars and credentials, and waits for the new job to complete.
+func RelaunchJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64, options restclient.JobWaitOptions) (*GetJobResponse, error) {
+	job, err := SubmitRelaunchJob(errorHandler, r, id, options)
+	return reportJobError(errorHandler, "error relaunching job", job, err)
+}
+
+// SubmitRelaunchJob relaunches a job like RelaunchJob, a failed new job is returned along with a *JobError like SubmitJob.
+func SubmitRelaunchJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64, options restclient.JobWaitOptions) (*GetJobResponse, error) {
+	resp, err := relaunchJob(errorHandler, r, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return waitForJobResponse(errorHandler, r, resp, options)
+}
+
+// StartRelaunchJob relaunches a job and returns the new job without waiting for its completion.
+func StartRelaunchJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) (*GetJobResponse, error) {
+	resp, err := relaunchJob(errorHandler, r, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return getJobResponse(errorHandler, r, resp)
+}
+
+// relaunchJob posts the relaunch of a job.
+func relaunchJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) (*CreateJobResponse, error) {
+	statusCode, response, err := r.CallCreateMethod(fmt.Sprintf("job/%d/relaunch", id), nil, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error relaunching job", fmt.Sprintf("error on POST job/%d/relaunch: %s, statusCode %d", id, err, statusCode))
+	}
+	if response.NumRecords == 0 {
+		return nil, errorHandler.MakeAndReportError("error relaunching job", fmt.Sprintf("no response for POST job/%d/relaunch, statusCode %d", id, statusCode))
+	}
+
+	var resp *CreateJobResponse
+	if err = mapstructure.Decode(response.Records[0], &resp); err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from POST job/relaunch", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+
+	return resp, nil
+}
+
+// AbortJob aborts a running job.
+func AbortJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) error {
+	statusCode, _, err := r.CallCreateMethod(fmt.Sprintf("job/%d/abort", id), nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error aborting job", fmt.Sprintf("error on POST job/%d/abort: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
+
+// ListJobs lists jobs, filter is passed as query parameters.
+func ListJobs(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter map[string]any) ([]JobGetDataSourceModel, error) {
+	query := r.NewQuery()
+	query.SetValues(filter)
+	statusCode, response, err := r.GetZeroOrMoreRecords("job", query, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error listing jobs", fmt.Sprintf("error on GET job: %s, statusCode %d", err, statusCode))
+	}
+	if len(response) == 0 {
+		return nil, nil
+	}
+
+	var apiResp *ListJobsResponse
+	if err = decodeJobs(response[0], &apiResp); err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET job", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("listed %d jobs", len(apiResp.Data)))
+
+	return apiResp.Data, nil
+}
+
+// GetJobOutput gets the output of a job.
+func GetJobOutput(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) (string, error) {
+	statusCode, response, err := r.GetNilOrOneRecord(fmt.Sprintf("job/%d/output", id), nil, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET job output")
+	}
+	if err != nil {
+		return "", errorHandler.MakeAndReportError("error reading job output", fmt.Sprintf("error on GET job/%d/output: %s, statusCode %d", id, err, statusCode))
+	}
+
+	var apiResp *GetJobOutputResponse
+	if err = mapstructure.Decode(response, &apiResp); err != nil {
+		return "", errorHandler.MakeAndReportError("failed to decode response from GET job output", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+
+	return apiResp.Data.Output, nil
+}
+
+// JobFilterModel describes the filters applied to a list of jobs.
+// Ansible Forms does not filter jobs server side, so filters are applied on the listed jobs.
+type JobFilterModel struct {
+	Form        string
+	Status      string
+	User        string
+	Target      string
+	StartAfter  time.Time
+	StartBefore time.Time
+	Limit       int
+	OldestFirst bool
+}
+
+// jobTimeLayouts are the layouts used by Ansible Forms for job start and end times.
+var jobTimeLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02T15:04:05"}
+
+// ParseJobTime parses a job start or end time.
+func ParseJobTime(value string) (time.Time, error) {
+	for _, layout := range jobTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unable to parse job time %q", value)
+}
+
+// hasFilters tells whether some jobs may be filtered out.
+func (f *JobFilterModel) hasFilters() bool {
+	return f.Form != "" || f.Status != "" || f.User != "" || f.Target != "" || !f.StartAfter.IsZero() || !f.StartBefore.IsZero()
+}
+
+// match tells whether a job matches the filter.
+func (f *JobFilterModel) match(job JobGetDataSourceModel) bool {
+	if f.Form != "" && f.Form != job.Form {
+		return false
+	}
+	if f.Status != "" && f.Status != job.Status {
+		return false
+	}
+	if f.User != "" && f.User != job.User {
+		return false
+	}
+	if f.Target != "" && f.Target != job.Target {
+		return false
+	}
+	if f.StartAfter.IsZero() && f.StartBefore.IsZero() {
+		return true
+	}
+	start, err := ParseJobTime(job.Start)
+	if err != nil {
+		return false
+	}
+	if !f.StartAfter.IsZero() && start.Before(f.StartAfter) {
+		return false
+	}
+	if !f.StartBefore.IsZero() && start.After(f.StartBefore) {
+		return false
+	}
+
+	return true
+}
+
+// FilterJobs lists jobs matching the filter, most recent first unless OldestFirst is set.
+func FilterJobs(errorHandler *utils.ErrorHandler, r restclient.RestClient, filter JobFilterModel) ([]JobGetDataSourceModel, error) {
+	query := map[string]any{}
+	if !filter.hasFilters() && !filter.OldestFirst && filter.Limit > 0 {
+		// without filters, the most recent jobs can be limited server side
+		query["records"] = filter.Limit
+	}
+	jobs, err := ListJobs(errorHandler, r, query)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]JobGetDataSourceModel, 0, len(jobs))
+	for _, job := range jobs {
+		if filter.match(job) {
+			filtered = append(filtered, job)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filter.OldestFirst {
+			return filtered[i].ID < filtered[j].ID
+		}
+		return filtered[i].ID > filtered[j].ID
+	})
+	if filter.Limit > 0 && len(filtered) > filter.Limit {
+		filtered = filtered[:filter.Limit]
+	}
+
+	return filtered, nil
+}
+
+// JobListStatusRunning is the status of a running job in the list of jobs.
+const JobListStatusRunning = "running"
+
+// WaitForRunningJobs waits until no running job has the extra var name set to value, for at most timeout.
+func WaitForRunningJobs(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, value string, timeout time.Duration) error {
+	start := time.Now()
+	for {
+		jobs, err := FilterJobs(errorHandler, r, JobFilterModel{Status: JobListStatusRunning})
+		if err != nil {
+			return err
+		}
+		var running []int64
+		for _, job := range jobs {
+			if v, ok := job.Extravars[name]; ok && fmt.Sprintf("%v", v) == value {
+				running = append(running, job.ID)
+			}
+		}
+		waited := time.Since(start).Round(time.Second)
+		if len(running) == 0 {
+			if waited > 0 {
+				tflog.Info(errorHandler.Ctx, fmt.Sprintf("waited %s for running jobs with %s %s", waited, name, value))
+			}
+			return nil
+		}
+		if waited > timeout {
+			return errorHandler.MakeAndReportError("error waiting for running jobs", fmt.Sprintf("jobs %v with %s %s still running after %s", running, name, value, timeout))
+		}
+		tflog.Info(errorHandler.Ctx, fmt.Sprintf("waiting for running jobs %v with %s %s, waited %s", running, name, value, waited))
+		<-time.After(restclient.CheckLoopInterval)
+	}
+}
+
+// jobStartSkew is the tolerance on the start time of the jobs found by FindRecentJob, as job start times are rounded to the second,
+// and the clocks of Terraform and Ansible Forms may differ.
+const jobStartSkew = 30 * time.Second
+
+// FindRecentJob returns the most recent job of form started since the given time, among the limit most recent ones,
+// with the extra var name set to value, or nil.
+func FindRecentJob(errorHandler *utils.ErrorHandler, r restclient.RestClient, form string, name string, value string, since time.Time, limit int) (*JobGetDataSourceModel, error) {
+	if !since.IsZero() {
+		since = since.Truncate(time.Second).Add(-jobStartSkew)
+	}
+	jobs, err := FilterJobs(errorHandler, r, JobFilterModel{Form: form, StartAfter: since, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		if v, ok := job.Extravars[name]; ok && fmt.Sprintf("%v", v) == value {
+			return &job, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,104 @@
+package interfaces
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// JobBatchItem describes a job of a batch.
+type JobBatchItem struct {
+	Key  string
+	Data JobResourceModel
+}
+
+// JobBatchResult describes the outcome of a job of a batch.
+type JobBatchResult struct {
+	Key string
+	// Job is nil when the job was not launched or could not be read.
+	Job *JobGetDataSourceModel
+	// Skipped is set when the job was not launched after an earlier failure.
+	Skipped bool
+	// Err is a *JobError when the job was launched but did not complete successfully, other errors are reported in Diags.
+	Err   error
+	Diags diag.Diagnostics
+}
+
+// JobBatchOptions controls how a batch of jobs is run.
+type JobBatchOptions struct {
+	// MaxParallel is the maximum number of jobs running at the same time.
+	MaxParallel int
+	// StopOnFirstFailure skips the jobs not launched yet as soon as a job fails.
+	StopOnFirstFailure bool
+	Wait               restclient.JobWaitOptions
+}
+
+// jobBatchRunner runs the job of a batch item, and returns the job when it was launched.
+type jobBatchRunner func(errorHandler *utils.ErrorHandler, item JobBatchItem) (*JobGetDataSourceModel, error)
+
+// RunJobBatch runs the jobs of items with at most MaxParallel jobs at a time, and returns a result per item in the order of items.
+// The jobs share the rest client, each result has its own diagnostics so that jobs can report errors concurrently.
+func RunJobBatch(errorHandler *utils.ErrorHandler, r restclient.RestClient, items []JobBatchItem, options JobBatchOptions) []JobBatchResult {
+	return runJobBatch(errorHandler, items, options, func(itemErrorHandler *utils.ErrorHandler, item JobBatchItem) (*JobGetDataSourceModel, error) {
+		job, err := SubmitJob(itemErrorHandler, r, item.Data, options.Wait)
+		if job == nil {
+			return nil, err
+		}
+		return &job.Data, err
+	})
+}
+
+// runJobBatch runs the jobs of items with run, with at most MaxParallel jobs at a time.
+func runJobBatch(errorHandler *utils.ErrorHandler, items []JobBatchItem, options JobBatchOptions, run jobBatchRunner) []JobBatchResult {
+	maxParallel := options.MaxParallel
+	if maxParallel <= 0 {
+		maxParallel = 1
+	}
+	results := make([]JobBatchResult, len(items))
+	indexes := make(chan int)
+	var failed atomic.Bool
+	var wg sync.WaitGroup
+	for w := 0; w < maxParallel && w < len(items); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = runJobBatchItem(errorHandler, items[i], options, run, &failed)
+			}
+		}()
+	}
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+// runJobBatchItem runs the job of a batch item, unless an earlier job failed and options.StopOnFirstFailure is set.
+func runJobBatchItem(errorHandler *utils.ErrorHandler, item JobBatchItem, options JobBatchOptions, run jobBatchRunner, failed *atomic.Bool) JobBatchResult {
+	result := JobBatchResult{Key: item.Key}
+	if options.StopOnFirstFailure && failed.Load() {
+		tflog.Info(errorHandler.Ctx, fmt.Sprintf("skipping job for %s after an earlier failure", item.Key))
+		result.Skipped = true
+		return result
+	}
+
+	itemErrorHandler := utils.NewErrorHandler(errorHandler.Ctx, &result.Diags)
+	job, err := run(itemErrorHandler, item)
+	result.Job = job
+	result.Err = err
+	if err != nil {
+		failed.Store(true)
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("job for %s done, error: %v", item.Key, err))
+
+	return result
+}
@@ -0,0 +1,163 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+func testJobBatchItems(keys ...string) []JobBatchItem {
+	items := make([]JobBatchItem, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, JobBatchItem{Key: key, Data: JobResourceModel{Form: key}})
+	}
+	return items
+}
+
+func TestRunJobBatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     JobBatchOptions
+		keys        []string
+		failing     string
+		wantKeys    []string
+		wantSkipped []string
+	}{
+		{
+			name:     "results in the order of items",
+			options:  JobBatchOptions{MaxParallel: 3},
+			keys:     []string{"e", "d", "c", "b", "a"},
+			wantKeys: []string{"e", "d", "c", "b", "a"},
+		},
+		{
+			name:     "no item",
+			options:  JobBatchOptions{MaxParallel: 3},
+			wantKeys: []string{},
+		},
+		{
+			name:     "failure without stop",
+			options:  JobBatchOptions{MaxParallel: 1},
+			keys:     []string{"a", "b", "c"},
+			failing:  "a",
+			wantKeys: []string{"a", "b", "c"},
+		},
+		{
+			name:        "stop on first failure",
+			options:     JobBatchOptions{MaxParallel: 1, StopOnFirstFailure: true},
+			keys:        []string{"a", "b", "c"},
+			failing:     "b",
+			wantKeys:    []string{"a", "b", "c"},
+			wantSkipped: []string{"c"},
+		},
+		{
+			name:        "stop on first failure with the default max parallel",
+			options:     JobBatchOptions{StopOnFirstFailure: true},
+			keys:        []string{"a", "b", "c"},
+			failing:     "a",
+			wantKeys:    []string{"a", "b", "c"},
+			wantSkipped: []string{"b", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			errorHandler := utils.NewErrorHandler(context.Background(), &diags)
+			results := runJobBatch(errorHandler, testJobBatchItems(tt.keys...), tt.options, func(itemErrorHandler *utils.ErrorHandler, item JobBatchItem) (*JobGetDataSourceModel, error) {
+				if item.Key == tt.failing {
+					return nil, itemErrorHandler.MakeAndReportError("error launching job", item.Key)
+				}
+				return &JobGetDataSourceModel{Status: "success"}, nil
+			})
+
+			keys := []string{}
+			skipped := []string(nil)
+			for _, result := range results {
+				keys = append(keys, result.Key)
+				if result.Skipped {
+					skipped = append(skipped, result.Key)
+					if result.Job != nil || result.Err != nil {
+						t.Errorf("skipped item %s has a job or an error", result.Key)
+					}
+					continue
+				}
+				if failed := result.Key == tt.failing; failed != (result.Err != nil) || failed != result.Diags.HasError() {
+					t.Errorf("item %s: error %v, diagnostics %v", result.Key, result.Err, result.Diags)
+				}
+			}
+			if !reflect.DeepEqual(keys, tt.wantKeys) {
+				t.Errorf("got results %v, want %v", keys, tt.wantKeys)
+			}
+			if !reflect.DeepEqual(skipped, tt.wantSkipped) {
+				t.Errorf("got skipped %v, want %v", skipped, tt.wantSkipped)
+			}
+			if diags.HasError() {
+				t.Errorf("item errors reported in the batch diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestRunJobBatch_maxParallel(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxParallel int
+		items       int
+		want        int32
+	}{
+		{name: "bounded", maxParallel: 3, items: 10, want: 3},
+		{name: "fewer items", maxParallel: 5, items: 2, want: 2},
+		{name: "default", maxParallel: 0, items: 4, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := make([]string, tt.items)
+			for i := range keys {
+				keys[i] = string(rune('a' + i))
+			}
+			var running, peak atomic.Int32
+			var diags diag.Diagnostics
+			results := runJobBatch(utils.NewErrorHandler(context.Background(), &diags), testJobBatchItems(keys...), JobBatchOptions{MaxParallel: tt.maxParallel},
+				func(_ *utils.ErrorHandler, _ JobBatchItem) (*JobGetDataSourceModel, error) {
+					current := running.Add(1)
+					for {
+						previous := peak.Load()
+						if current <= previous || peak.CompareAndSwap(previous, current) {
+							break
+						}
+					}
+					time.Sleep(20 * time.Millisecond)
+					running.Add(-1)
+					return &JobGetDataSourceModel{}, nil
+				})
+
+			if len(results) != tt.items {
+				t.Fatalf("got %d results, want %d", len(results), tt.items)
+			}
+			if got := peak.Load(); got > tt.want {
+				t.Errorf("ran %d jobs at the same time, want at most %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunJobBatch_jobError(t *testing.T) {
+	var diags diag.Diagnostics
+	jobErr := &JobError{ID: 1, Status: "error", Err: errors.New("job failed")}
+	results := runJobBatch(utils.NewErrorHandler(context.Background(), &diags), testJobBatchItems("a"), JobBatchOptions{},
+		func(_ *utils.ErrorHandler, _ JobBatchItem) (*JobGetDataSourceModel, error) {
+			return &JobGetDataSourceModel{Status: "error"}, jobErr
+		})
+	if results[0].Job == nil || results[0].Job.Status != "error" {
+		t.Errorf("got job %v, want the failed job", results[0].Job)
+	}
+	if !errors.Is(results[0].Err, jobErr) {
+		t.Errorf("got error %v, want the job error", results[0].Err)
+	}
+}
@@ -0,0 +1,230 @@
+package interfaces
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// testJobTime formats a job start time the way Ansible Forms does.
+func testJobTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04:05")
+}
+
+// testListJobsResponse mocks the response of GET job listing jobs.
+func testListJobsResponse(jobs ...map[string]any) restclient.MockResponse {
+	data := make([]any, 0, len(jobs))
+	for _, job := range jobs {
+		data = append(data, job)
+	}
+	record := map[string]any{"status": "success", "data": data}
+
+	return restclient.MockResponse{ExpectedMethod: "GET", ExpectedURL: "job", StatusCode: 200,
+		Response: restclient.RestResponse{NumRecords: 1, Records: []map[string]any{record}}}
+}
+
+func TestFindRecentJob(t *testing.T) {
+	// job start times have a second precision, since has nanoseconds
+	since := time.Date(2026, 3, 1, 12, 0, 0, 700000000, time.UTC)
+	job := func(id int64, form string, fingerprint string, status string, start time.Time) map[string]any {
+		return map[string]any{"id": id, "formName": form, "status": status, "start": testJobTime(start),
+			"extravars": map[string]any{"terraform_fingerprint": fingerprint}}
+	}
+
+	tests := []struct {
+		name   string
+		jobs   []map[string]any
+		wantID int64
+	}{
+		{
+			name:   "started in the same second",
+			jobs:   []map[string]any{job(1, "form", "abc", "success", since.Truncate(time.Second))},
+			wantID: 1,
+		},
+		{
+			name:   "started within the skew tolerance",
+			jobs:   []map[string]any{job(1, "form", "abc", "running", since.Add(-10*time.Second))},
+			wantID: 1,
+		},
+		{
+			name: "started before",
+			jobs: []map[string]any{job(1, "form", "abc", "success", since.Add(-time.Minute))},
+		},
+		{
+			name: "most recent job with the value, whatever its status",
+			jobs: []map[string]any{
+				job(1, "form", "abc", "success", since.Add(time.Second)),
+				job(2, "form", "abc", "error", since.Add(2*time.Second)),
+				job(3, "form", "other", "success", since.Add(3*time.Second)),
+			},
+			wantID: 2,
+		},
+		{
+			name: "other form",
+			jobs: []map[string]any{job(1, "other", "abc", "success", since.Add(time.Second))},
+		},
+		{
+			name: "no job",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := restclient.NewMockedRestClient([]restclient.MockResponse{testListJobsResponse(tt.jobs...)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var diags diag.Diagnostics
+			errorHandler := utils.NewErrorHandler(context.Background(), &diags)
+
+			found, err := FindRecentJob(errorHandler, *client, "form", "terraform_fingerprint", "abc", since, 50)
+			if err != nil {
+				t.Fatalf("FindRecentJob() error = %v", err)
+			}
+			var gotID int64
+			if found != nil {
+				gotID = found.ID
+			}
+			if gotID != tt.wantID {
+				t.Errorf("FindRecentJob() = job %d, want job %d", gotID, tt.wantID)
+			}
+			if left := client.MockResponsesLeft(); left != 0 {
+				t.Errorf("%d responses not requested", left)
+			}
+		})
+	}
+}
+
+func TestJobFilterModel_match(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	job := JobGetDataSourceModel{ID: 1, Form: "demo", Status: "success", User: "admin", Target: "svm1", Start: testJobTime(start)}
+
+	tests := []struct {
+		name   string
+		filter JobFilterModel
+		job    JobGetDataSourceModel
+		want   bool
+	}{
+		{name: "no filter", filter: JobFilterModel{}, job: job, want: true},
+		{name: "no filter and no start time", filter: JobFilterModel{}, job: JobGetDataSourceModel{ID: 2}, want: true},
+		{name: "form", filter: JobFilterModel{Form: "demo"}, job: job, want: true},
+		{name: "other form", filter: JobFilterModel{Form: "other"}, job: job, want: false},
+		{name: "status", filter: JobFilterModel{Status: "success"}, job: job, want: true},
+		{name: "other status", filter: JobFilterModel{Status: "error"}, job: job, want: false},
+		{name: "user", filter: JobFilterModel{User: "admin"}, job: job, want: true},
+		{name: "other user", filter: JobFilterModel{User: "operator"}, job: job, want: false},
+		{name: "target", filter: JobFilterModel{Target: "svm1"}, job: job, want: true},
+		{name: "other target", filter: JobFilterModel{Target: "svm2"}, job: job, want: false},
+		{name: "all filters", filter: JobFilterModel{Form: "demo", Status: "success", User: "admin", Target: "svm1"}, job: job, want: true},
+		{name: "all filters but one", filter: JobFilterModel{Form: "demo", Status: "success", User: "admin", Target: "svm2"}, job: job, want: false},
+		{name: "started after", filter: JobFilterModel{StartAfter: start.Add(-time.Minute)}, job: job, want: true},
+		{name: "started at the start after bound", filter: JobFilterModel{StartAfter: start}, job: job, want: true},
+		{name: "started before start after", filter: JobFilterModel{StartAfter: start.Add(time.Minute)}, job: job, want: false},
+		{name: "started before", filter: JobFilterModel{StartBefore: start.Add(time.Minute)}, job: job, want: true},
+		{name: "started at the start before bound", filter: JobFilterModel{StartBefore: start}, job: job, want: true},
+		{name: "started after start before", filter: JobFilterModel{StartBefore: start.Add(-time.Minute)}, job: job, want: false},
+		{name: "started between", filter: JobFilterModel{StartAfter: start.Add(-time.Minute), StartBefore: start.Add(time.Minute)}, job: job, want: true},
+		{name: "RFC 3339 start time", filter: JobFilterModel{StartAfter: start.Add(-time.Minute)}, job: JobGetDataSourceModel{Start: start.Format(time.RFC3339)}, want: true},
+		{name: "time filter and no start time", filter: JobFilterModel{StartAfter: start.Add(-time.Minute)}, job: JobGetDataSourceModel{ID: 2}, want: false},
+		{name: "time filter and invalid start time", filter: JobFilterModel{StartBefore: start}, job: JobGetDataSourceModel{Start: "yesterday"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.match(tt.job); got != tt.want {
+				t.Errorf("JobFilterModel.match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterJobs(t *testing.T) {
+	job := func(id int64, form string) map[string]any {
+		return map[string]any{"id": id, "formName": form, "status": "success"}
+	}
+	jobs := []map[string]any{job(3, "demo"), job(1, "demo"), job(4, "other"), job(2, "demo"), job(5, "demo")}
+
+	tests := []struct {
+		name    string
+		filter  JobFilterModel
+		wantIDs []int64
+	}{
+		{name: "most recent first", filter: JobFilterModel{}, wantIDs: []int64{5, 4, 3, 2, 1}},
+		{name: "oldest first", filter: JobFilterModel{OldestFirst: true}, wantIDs: []int64{1, 2, 3, 4, 5}},
+		{name: "limit", filter: JobFilterModel{Limit: 2}, wantIDs: []int64{5, 4}},
+		{name: "limit oldest first", filter: JobFilterModel{Limit: 2, OldestFirst: true}, wantIDs: []int64{1, 2}},
+		{name: "limit applied after the filters", filter: JobFilterModel{Form: "demo", Limit: 3}, wantIDs: []int64{5, 3, 2}},
+		{name: "limit above the number of jobs", filter: JobFilterModel{Form: "other", Limit: 3}, wantIDs: []int64{4}},
+		{name: "no match", filter: JobFilterModel{Form: "none", Limit: 3}, wantIDs: []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := restclient.NewMockedRestClient([]restclient.MockResponse{testListJobsResponse(jobs...)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var diags diag.Diagnostics
+			errorHandler := utils.NewErrorHandler(context.Background(), &diags)
+
+			got, err := FilterJobs(errorHandler, *client, tt.filter)
+			if err != nil {
+				t.Fatalf("FilterJobs() error = %v", err)
+			}
+			gotIDs := make([]int64, 0, len(got))
+			for _, job := range got {
+				gotIDs = append(gotIDs, job.ID)
+			}
+			if !reflect.DeepEqual(gotIDs, tt.wantIDs) {
+				t.Errorf("FilterJobs() = jobs %v, want jobs %v", gotIDs, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestFindJobByID(t *testing.T) {
+	record := func(record map[string]any) []restclient.MockResponse {
+		return []restclient.MockResponse{{ExpectedMethod: "GET", ExpectedURL: "job/12", StatusCode: 200,
+			Response: restclient.RestResponse{NumRecords: 1, Records: []map[string]any{record}}}}
+	}
+	tests := []struct {
+		name      string
+		responses []restclient.MockResponse
+		wantFound bool
+	}{
+		{
+			name:      "found",
+			responses: record(map[string]any{"status": "success", "data": map[string]any{"id": 12, "status": "success"}}),
+			wantFound: true,
+		},
+		{
+			name:      "deleted job",
+			responses: record(map[string]any{"status": "error", "message": "failed to find job"}),
+		},
+		{
+			name:      "no record",
+			responses: []restclient.MockResponse{{ExpectedMethod: "GET", ExpectedURL: "job/12", StatusCode: 200}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var diags diag.Diagnostics
+			errorHandler := utils.NewErrorHandler(context.Background(), &diags)
+
+			job, err := FindJobByID(errorHandler, *client, 12)
+			if err != nil || diags.HasError() {
+				t.Fatalf("FindJobByID() error = %v, diagnostics %v", err, diags)
+			}
+			if (job != nil) != tt.wantFound {
+				t.Errorf("FindJobByID() = %v, want found %v", job, tt.wantFound)
+			}
+		})
+	}
+}
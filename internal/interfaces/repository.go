@@ -0,0 +1,137 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// RepositoryDataModel describes a git repository of Ansible Forms.
+type RepositoryDataModel struct {
+	Name            string `mapstructure:"name"`
+	URI             string `mapstructure:"uri"`
+	Branch          string `mapstructure:"branch"`
+	Credential      string `mapstructure:"credential"`
+	Description     string `mapstructure:"description"`
+	Cron            string `mapstructure:"cron"`
+	UseForForms     bool   `mapstructure:"use_for_forms"`
+	UseForPlaybooks bool   `mapstructure:"use_for_playbooks"`
+}
+
+// repositoryAPI returns the API path of the repository named name.
+func repositoryAPI(name string, action ...string) string {
+	return strings.Join(append([]string{"repository", url.PathEscape(name)}, action...), "/")
+}
+
+// GetRepository gets a repository by name, nil is returned if it does not exist.
+func GetRepository(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (*RepositoryDataModel, error) {
+	api := repositoryAPI(name)
+	statusCode, response, err := r.GetNilOrOneRecord(api, nil, nil)
+	if statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading repository info", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var repository RepositoryDataModel
+	found, err := decodeData(response, &repository)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET repository", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	if !found || repository.Name == "" {
+		return nil, nil
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("read repository info: %#v", repository))
+
+	return &repository, nil
+}
+
+// CreateRepository creates a repository, Ansible Forms clones it on creation.
+func CreateRepository(errorHandler *utils.ErrorHandler, r restclient.RestClient, data RepositoryDataModel) error {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding repository body", fmt.Sprintf("error on encoding POST repository body: %s", err))
+	}
+	statusCode, _, err := r.CallCreateMethod("repository", nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error creating repository", fmt.Sprintf("error on POST repository: %s, statusCode %d", err, statusCode))
+	}
+
+	return nil
+}
+
+// UpdateRepository updates a repository.
+func UpdateRepository(errorHandler *utils.ErrorHandler, r restclient.RestClient, data RepositoryDataModel) error {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding repository body", fmt.Sprintf("error on encoding PUT repository body: %s", err))
+	}
+	api := repositoryAPI(data.Name)
+	statusCode, _, err := r.CallUpdateMethod(api, nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating repository", fmt.Sprintf("error on PUT %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	return nil
+}
+
+// DeleteRepository deletes a repository and its local clone.
+func DeleteRepository(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) error {
+	api := repositoryAPI(name)
+	statusCode, _, err := r.CallDeleteMethod(api, nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting repository", fmt.Sprintf("error on DELETE %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	return nil
+}
+
+// PullRepository pulls a repository, or resets it to the remote branch when reset is true, and returns the git output.
+func PullRepository(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string, reset bool) (string, error) {
+	action := "pull"
+	if reset {
+		action = "reset"
+	}
+	api := repositoryAPI(name, action)
+	statusCode, response, err := r.CallCreateMethod(api, nil, nil)
+	if err == nil && response.NumRecords > 0 && response.Records[0]["status"] == restclient.AnsibleStatusFailure {
+		err = fmt.Errorf("%v %v", response.Records[0]["message"], response.Records[0]["data"])
+	}
+	if err != nil {
+		return "", errorHandler.MakeAndReportError("error pulling repository", fmt.Sprintf("error on POST %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var output any
+	if response.NumRecords > 0 {
+		if _, err = decodeData(response.Records[0], &output); err != nil {
+			return "", errorHandler.MakeAndReportError("failed to decode response from POST repository", fmt.Sprintf("error: %s, statusCode %d", err, statusCode))
+		}
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("pulled repository %s: %v", name, output))
+
+	return fmt.Sprint(output), nil
+}
+
+// GetRepositoryCommit returns the last commit of the local clone of a repository.
+func GetRepositoryCommit(errorHandler *utils.ErrorHandler, r restclient.RestClient, name string) (string, error) {
+	api := repositoryAPI(name, "lastcommit")
+	statusCode, response, err := r.GetNilOrOneRecord(api, nil, nil)
+	if err != nil {
+		return "", errorHandler.MakeAndReportError("error reading repository commit", fmt.Sprintf("error on GET %s: %s, statusCode %d", api, err, statusCode))
+	}
+
+	var commit string
+	if _, err = decodeData(response, &commit); err != nil {
+		return "", errorHandler.MakeAndReportError("failed to decode response from GET repository commit", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+
+	return strings.TrimSpace(commit), nil
+}
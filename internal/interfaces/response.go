@@ -0,0 +1,50 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+)
+
+// CreateResponse describes the response of a POST creating an object.
+type CreateResponse struct {
+	Status  string `mapstructure:"status"`
+	Message string `mapstructure:"message"`
+	Data    struct {
+		Output struct {
+			ID int64 `mapstructure:"id"`
+		} `mapstructure:"output"`
+	} `mapstructure:"data"`
+}
+
+// decodeCreatedID returns the ID of the object created by a POST.
+func decodeCreatedID(response restclient.RestResponse) (int64, error) {
+	if response.NumRecords == 0 {
+		return 0, fmt.Errorf("no record in response")
+	}
+	var resp CreateResponse
+	if err := mapstructure.WeakDecode(response.Records[0], &resp); err != nil {
+		return 0, err
+	}
+
+	return resp.Data.Output.ID, nil
+}
+
+// decodeData decodes the data of an Ansible Forms response into output, found is false when there is no data.
+func decodeData(record map[string]any, output any) (found bool, err error) {
+	data, ok := record["data"]
+	if !ok || data == nil {
+		return false, nil
+	}
+	// some objects are returned in data.output
+	if m, ok := data.(map[string]any); ok && len(m) == 1 && m["output"] != nil {
+		data = m["output"]
+	}
+	if err = mapstructure.WeakDecode(data, output); err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
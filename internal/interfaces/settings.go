@@ -0,0 +1,51 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// SettingsDataModel describes the mail and server URL settings of Ansible Forms.
+type SettingsDataModel struct {
+	URL          string `mapstructure:"url"`
+	MailServer   string `mapstructure:"mail_server"`
+	MailPort     int64  `mapstructure:"mail_port"`
+	MailSecure   bool   `mapstructure:"mail_secure"`
+	MailUsername string `mapstructure:"mail_username"`
+	MailPassword string `mapstructure:"mail_password,omitempty"`
+	MailFrom     string `mapstructure:"mail_from"`
+}
+
+// GetSettings gets the settings, the mail password is not returned in clear text.
+func GetSettings(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*SettingsDataModel, error) {
+	var settings SettingsDataModel
+	if err := getSettings(errorHandler, r, "settings", &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// UpdateSettings updates the settings, the mail password is left unchanged when empty.
+func UpdateSettings(errorHandler *utils.ErrorHandler, r restclient.RestClient, data SettingsDataModel) error {
+	return updateSettings(errorHandler, r, "settings", data)
+}
+
+// SendTestMail asks Ansible Forms to send a test mail to the given address with the saved settings.
+func SendTestMail(errorHandler *utils.ErrorHandler, r restclient.RestClient, to string) error {
+	body := map[string]any{"to": to}
+	statusCode, response, err := r.CallCreateMethod("settings/mailcheck", nil, body)
+	if err == nil && response.NumRecords > 0 && response.Records[0]["status"] == restclient.AnsibleStatusFailure {
+		err = fmt.Errorf("%v %v", response.Records[0]["message"], response.Records[0]["data"])
+	}
+	if err != nil {
+		return errorHandler.MakeAndReportError("test mail failed", fmt.Sprintf("error on POST settings/mailcheck: %s, statusCode %d", err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("test mail sent to %s", to))
+
+	return nil
+}
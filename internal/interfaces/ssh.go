@@ -0,0 +1,110 @@
+package interfaces
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// SSHKeyDataModel describes the SSH key of Ansible Forms, the private key is never returned.
+type SSHKeyDataModel struct {
+	PublicKey string `mapstructure:"public_key"`
+}
+
+// GetSSHKey gets the SSH key used by Ansible Forms to connect to target hosts.
+func GetSSHKey(errorHandler *utils.ErrorHandler, r restclient.RestClient) (*SSHKeyDataModel, error) {
+	statusCode, response, err := r.GetNilOrOneRecord("sshkey", nil, nil)
+	if err == nil && response == nil {
+		err = fmt.Errorf("no response for GET sshkey")
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading SSH key", fmt.Sprintf("error on GET sshkey: %s, statusCode %d", err, statusCode))
+	}
+
+	var key SSHKeyDataModel
+	if _, err = decodeData(response, &key); err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET sshkey", fmt.Sprintf("error: %s, statusCode %d", err, statusCode))
+	}
+
+	return &key, nil
+}
+
+// UpdateSSHKey replaces the SSH private key, Ansible Forms derives the public key from it.
+func UpdateSSHKey(errorHandler *utils.ErrorHandler, r restclient.RestClient, privateKey string) error {
+	body := map[string]any{"private_key": privateKey}
+	statusCode, _, err := r.CallUpdateMethod("sshkey", nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating SSH key", fmt.Sprintf("error on PUT sshkey: %s, statusCode %d", err, statusCode))
+	}
+	tflog.Debug(errorHandler.Ctx, "saved SSH key")
+
+	return nil
+}
+
+// ListKnownHosts returns the lines of the known_hosts file of Ansible Forms.
+func ListKnownHosts(errorHandler *utils.ErrorHandler, r restclient.RestClient) ([]string, error) {
+	statusCode, response, err := r.GetNilOrOneRecord("knownhosts", nil, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading known hosts", fmt.Sprintf("error on GET knownhosts: %s, statusCode %d", err, statusCode))
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	var lines []string
+	if _, err = decodeData(response, &lines); err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET knownhosts", fmt.Sprintf("error: %s, statusCode %d", err, statusCode))
+	}
+
+	return lines, nil
+}
+
+// KnownHostEntries returns the known_hosts lines of host.
+func KnownHostEntries(lines []string, host string) []string {
+	var entries []string
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if slices.Contains(strings.Split(fields[0], ","), host) {
+			entries = append(entries, strings.TrimSpace(line))
+		}
+	}
+
+	return entries
+}
+
+// AddKnownHost adds host to the known_hosts file, with key when set, otherwise with the keys scanned from host.
+func AddKnownHost(errorHandler *utils.ErrorHandler, r restclient.RestClient, host string, key string) error {
+	body := map[string]any{"name": host}
+	if key != "" {
+		body["key"] = key
+	}
+	statusCode, response, err := r.CallCreateMethod("knownhosts", nil, body)
+	if err == nil && response.NumRecords > 0 && response.Records[0]["status"] == restclient.AnsibleStatusFailure {
+		err = fmt.Errorf("%v %v", response.Records[0]["message"], response.Records[0]["data"])
+	}
+	if err != nil {
+		return errorHandler.MakeAndReportError("error adding known host", fmt.Sprintf("error on POST knownhosts for %s: %s, statusCode %d", host, err, statusCode))
+	}
+
+	return nil
+}
+
+// RemoveKnownHost removes the entries of host from the known_hosts file.
+func RemoveKnownHost(errorHandler *utils.ErrorHandler, r restclient.RestClient, host string) error {
+	query := r.NewQuery()
+	query.Set("name", host)
+	statusCode, _, err := r.CallDeleteMethod("knownhosts", query, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error removing known host", fmt.Sprintf("error on DELETE knownhosts for %s: %s, statusCode %d", host, err, statusCode))
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package interfaces
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// UserGetDataModel describes a local user as returned by Ansible Forms, the password is never returned.
+type UserGetDataModel struct {
+	ID       int64  `mapstructure:"id"`
+	Username string `mapstructure:"username"`
+	Email    string `mapstructure:"email"`
+	GroupID  int64  `mapstructure:"group_id"`
+}
+
+// UserResourceBodyDataModel describes the body to create or update a local user.
+type UserResourceBodyDataModel struct {
+	Username string `mapstructure:"username"`
+	Email    string `mapstructure:"email"`
+	GroupID  int64  `mapstructure:"group_id"`
+	Password string `mapstructure:"password,omitempty"`
+}
+
+// GetUserByID gets a local user by ID, nil is returned if it does not exist.
+func GetUserByID(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) (*UserGetDataModel, error) {
+	statusCode, response, err := r.GetNilOrOneRecord(fmt.Sprintf("user/%d", id), nil, nil)
+	if statusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading user info", fmt.Sprintf("error on GET user/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	var user UserGetDataModel
+	found, err := decodeData(response, &user)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET user", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	if !found || user.ID == 0 {
+		return nil, nil
+	}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("read user info: %#v", user))
+
+	return &user, nil
+}
+
+// GetUserByName gets a local user by username, nil is returned if it does not exist.
+func GetUserByName(errorHandler *utils.ErrorHandler, r restclient.RestClient, username string) (*UserGetDataModel, error) {
+	statusCode, response, err := r.GetZeroOrMoreRecords("user", nil, nil)
+	if err != nil {
+		return nil, errorHandler.MakeAndReportError("error reading users", fmt.Sprintf("error on GET user: %s, statusCode %d", err, statusCode))
+	}
+	if len(response) == 0 {
+		return nil, nil
+	}
+
+	var users []UserGetDataModel
+	if _, err = decodeData(response[0], &users); err != nil {
+		return nil, errorHandler.MakeAndReportError("failed to decode response from GET user", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+	for _, user := range users {
+		if user.Username == username {
+			return &user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateUser creates a local user and returns its ID.
+func CreateUser(errorHandler *utils.ErrorHandler, r restclient.RestClient, data UserResourceBodyDataModel) (int64, error) {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return 0, errorHandler.MakeAndReportError("error encoding user body", fmt.Sprintf("error on encoding POST user body: %s", err))
+	}
+	statusCode, response, err := r.CallCreateMethod("user", nil, body)
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("error creating user", fmt.Sprintf("error on POST user: %s, statusCode %d", err, statusCode))
+	}
+	id, err := decodeCreatedID(response)
+	if err != nil {
+		return 0, errorHandler.MakeAndReportError("failed to decode response from POST user", fmt.Sprintf("error: %s, statusCode %d, response %#v", err, statusCode, response))
+	}
+
+	return id, nil
+}
+
+// UpdateUser updates a local user, the password is kept when empty.
+func UpdateUser(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64, data UserResourceBodyDataModel) error {
+	var body map[string]any
+	if err := mapstructure.Decode(data, &body); err != nil {
+		return errorHandler.MakeAndReportError("error encoding user body", fmt.Sprintf("error on encoding PUT user body: %s", err))
+	}
+	statusCode, _, err := r.CallUpdateMethod(fmt.Sprintf("user/%d", id), nil, body)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error updating user", fmt.Sprintf("error on PUT user/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
+
+// DeleteUser deletes a local user.
+func DeleteUser(errorHandler *utils.ErrorHandler, r restclient.RestClient, id int64) error {
+	statusCode, _, err := r.CallDeleteMethod(fmt.Sprintf("user/%d", id), nil, nil)
+	if err != nil {
+		return errorHandler.MakeAndReportError("error deleting user", fmt.Sprintf("error on DELETE user/%d: %s, statusCode %d", id, err, statusCode))
+	}
+
+	return nil
+}
@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AWXInstanceResource{}
+	_ resource.ResourceWithConfigure   = &AWXInstanceResource{}
+	_ resource.ResourceWithImportState = &AWXInstanceResource{}
+)
+
+// NewAWXInstanceResource is a helper function to simplify the provider implementation.
+func NewAWXInstanceResource() resource.Resource {
+	return &AWXInstanceResource{
+		config: resourceOrDataSourceConfig{
+			name: "awx_instance",
+		},
+	}
+}
+
+// AWXInstanceResource is the resource implementation.
+type AWXInstanceResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// AWXInstanceResourceModel maps the resource schema data.
+type AWXInstanceResourceModel struct {
+	CxProfileName  types.String `tfsdk:"cx_profile_name"`
+	ID             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	URI            types.String `tfsdk:"uri"`
+	Token          types.String `tfsdk:"token"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	IgnoreCerts    types.Bool   `tfsdk:"ignore_certs"`
+	CABundle       types.String `tfsdk:"ca_bundle"`
+	TestConnection types.Bool   `tfsdk:"test_connection"`
+}
+
+// Metadata returns the resource type name.
+func (r *AWXInstanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *AWXInstanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "AWX or Tower instance used by forms of type awx to launch templates.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the AWX instance.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the AWX instance.",
+			},
+			"uri": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "URI of the AWX instance, for instance https://awx.example.com.",
+			},
+			"token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "OAuth2 token to authenticate to AWX. Exactly one of `token` or `username` is required.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("username")),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "User name to authenticate to AWX.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password to authenticate to AWX.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("username")),
+				},
+			},
+			"ignore_certs": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to skip the validation of the certificate of AWX.",
+			},
+			"ca_bundle": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "PEM encoded CA bundle used to validate the certificate of AWX.",
+			},
+			"test_connection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Test the connection to AWX before saving the instance, the apply fails if Ansible Forms cannot connect. Defaults to true.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *AWXInstanceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// body builds the request body, secrets are only sent when they are set.
+func (m *AWXInstanceResourceModel) body() interfaces.AWXInstanceResourceBodyDataModel {
+	return interfaces.AWXInstanceResourceBodyDataModel{
+		Name:           m.Name.ValueString(),
+		URI:            m.URI.ValueString(),
+		Token:          m.Token.ValueString(),
+		Username:       m.Username.ValueString(),
+		Password:       m.Password.ValueString(),
+		UseCredentials: !m.Username.IsNull(),
+		IgnoreCerts:    m.IgnoreCerts.ValueBool(),
+		CABundle:       m.CABundle.ValueString(),
+	}
+}
+
+// check tests the connection to AWX when requested.
+func (m *AWXInstanceResourceModel) check(errorHandler *utils.ErrorHandler, client restclient.RestClient) error {
+	if !m.TestConnection.ValueBool() {
+		return nil
+	}
+
+	return interfaces.CheckAWXInstance(errorHandler, client, m.body())
+}
+
+// Create a new resource.
+func (r *AWXInstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AWXInstanceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = data.check(errorHandler, *client); err != nil {
+		return
+	}
+	id, err := interfaces.CreateAWXInstance(errorHandler, *client, data.body())
+	if err != nil {
+		return
+	}
+	if id == 0 {
+		// some versions do not return the ID of the new instance
+		instance, err := interfaces.GetAWXInstanceByName(errorHandler, *client, data.Name.ValueString())
+		if err != nil {
+			return
+		}
+		if instance == nil {
+			errorHandler.MakeAndReportError("error creating AWX instance", fmt.Sprintf("AWX instance %s not found after creation", data.Name.ValueString()))
+			return
+		}
+		id = instance.ID
+	}
+	data.ID = types.Int64Value(id)
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *AWXInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AWXInstanceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var instance *interfaces.AWXInstanceGetDataModel
+	if data.ID.IsNull() {
+		// after import by name
+		instance, err = interfaces.GetAWXInstanceByName(errorHandler, *client, data.Name.ValueString())
+	} else {
+		instance, err = interfaces.GetAWXInstanceByID(errorHandler, *client, data.ID.ValueInt64())
+	}
+	if err != nil {
+		return
+	}
+	if instance == nil {
+		tflog.Debug(ctx, fmt.Sprintf("AWX instance %s not found, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// the token and password are not returned by Ansible Forms, keep the ones from the state
+	data.ID = types.Int64Value(instance.ID)
+	data.Name = types.StringValue(instance.Name)
+	data.URI = types.StringValue(instance.URI)
+	if instance.UseCredentials {
+		data.Username = types.StringValue(instance.Username)
+	} else {
+		data.Username = types.StringNull()
+	}
+	data.IgnoreCerts = types.BoolValue(instance.IgnoreCerts)
+	data.CABundle = types.StringValue(instance.CABundle)
+	if data.TestConnection.IsNull() {
+		// after import
+		data.TestConnection = types.BoolValue(true)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read an AWX instance resource: %d", instance.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *AWXInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AWXInstanceResourceModel
+	var state *AWXInstanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = data.check(errorHandler, *client); err != nil {
+		return
+	}
+	if err = interfaces.UpdateAWXInstance(errorHandler, *client, state.ID.ValueInt64(), data.body()); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *AWXInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AWXInstanceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.DeleteAWXInstance(errorHandler, *client, data.ID.ValueInt64()); err != nil {
+		return
+	}
+}
+
+// ImportState imports an AWX instance by name, using an ID of the form <name>,<cx_profile_name>.
+func (r *AWXInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
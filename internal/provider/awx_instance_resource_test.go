@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWXInstanceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWXInstanceResourceConfig("https://awx1.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_awx_instance.awx", "name", "tf_acc_awx"),
+					resource.TestCheckResourceAttr("ansible-forms_awx_instance.awx", "uri", "https://awx1.example.com"),
+					resource.TestCheckResourceAttr("ansible-forms_awx_instance.awx", "ignore_certs", "true"),
+					resource.TestCheckResourceAttrSet("ansible-forms_awx_instance.awx", "id")),
+			},
+			{
+				Config: testAccAWXInstanceResourceConfig("https://awx2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_awx_instance.awx", "uri", "https://awx2.example.com")),
+			},
+			{
+				ResourceName:            "ansible-forms_awx_instance.awx",
+				ImportState:             true,
+				ImportStateId:           "tf_acc_awx,cluster4",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token", "test_connection"},
+			},
+		},
+	})
+}
+
+func testAccAWXInstanceResourceConfig(uri string) string {
+	host := os.Getenv("TF_ACC_ANSIBLE_FORMS_HOST")
+	admin := os.Getenv("TF_ACC_ANSIBLE_FORMS_USER")
+	password := os.Getenv("TF_ACC_ANSIBLE_FORMS_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_ANSIBLE_FORMS_HOST, TF_ACC_ANSIBLE_FORMS_USER, and TF_ACC_ANSIBLE_FORMS_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "ansible-forms" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "ansible-forms_awx_instance" "awx" {
+  cx_profile_name = "cluster4"
+  name            = "tf_acc_awx"
+  uri             = "%s"
+  token           = "mytoken"
+  ignore_certs    = true
+  test_connection = false
+}`, host, admin, password, uri)
+}
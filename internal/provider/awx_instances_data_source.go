@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &AWXInstancesDataSource{}
+
+// AWXInstancesDataSource defines the data source implementation.
+type AWXInstancesDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// NewAWXInstancesDataSource is a helper function to simplify the provider implementation.
+func NewAWXInstancesDataSource() datasource.DataSource {
+	return &AWXInstancesDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "awx_instances",
+		},
+	}
+}
+
+// AWXInstancesDataSourceModel maps the data source schema data.
+type AWXInstancesDataSourceModel struct {
+	CxProfileName types.String                  `tfsdk:"cx_profile_name"`
+	Instances     []AWXInstancesDataSourceEntry `tfsdk:"instances"`
+}
+
+// AWXInstancesDataSourceEntry maps an AWX instance of the list.
+type AWXInstancesDataSourceEntry struct {
+	ID             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	URI            types.String `tfsdk:"uri"`
+	Username       types.String `tfsdk:"username"`
+	UseCredentials types.Bool   `tfsdk:"use_credentials"`
+	IgnoreCerts    types.Bool   `tfsdk:"ignore_certs"`
+}
+
+// Metadata returns the data source type name.
+func (d *AWXInstancesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *AWXInstancesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the AWX instances configured in Ansible Forms, without their secrets.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"instances": schema.ListNestedAttribute{
+				MarkdownDescription: "AWX instances.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "ID of an AWX instance.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of an AWX instance.",
+							Computed:            true,
+						},
+						"uri": schema.StringAttribute{
+							MarkdownDescription: "URI of an AWX instance.",
+							Computed:            true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "User name to authenticate to an AWX instance, empty when a token is used.",
+							Computed:            true,
+						},
+						"use_credentials": schema.BoolAttribute{
+							MarkdownDescription: "Whether a user name and password are used instead of a token.",
+							Computed:            true,
+						},
+						"ignore_certs": schema.BoolAttribute{
+							MarkdownDescription: "Whether the validation of the certificate of an AWX instance is skipped.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *AWXInstancesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *AWXInstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AWXInstancesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	instances, err := interfaces.ListAWXInstances(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside ListAWXInstances
+		return
+	}
+
+	data.Instances = make([]AWXInstancesDataSourceEntry, len(instances))
+	for index, instance := range instances {
+		data.Instances[index] = AWXInstancesDataSourceEntry{
+			ID:             types.Int64Value(instance.ID),
+			Name:           types.StringValue(instance.Name),
+			URI:            types.StringValue(instance.URI),
+			Username:       types.StringValue(instance.Username),
+			UseCredentials: types.BoolValue(instance.UseCredentials),
+			IgnoreCerts:    types.BoolValue(instance.IgnoreCerts),
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %d AWX instances", len(data.Instances)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &AzureADSettingsResource{}
+	_ resource.ResourceWithConfigure   = &AzureADSettingsResource{}
+	_ resource.ResourceWithImportState = &AzureADSettingsResource{}
+)
+
+// NewAzureADSettingsResource is a helper function to simplify the provider implementation.
+func NewAzureADSettingsResource() resource.Resource {
+	return &AzureADSettingsResource{
+		config: resourceOrDataSourceConfig{
+			name: "azuread_settings",
+		},
+	}
+}
+
+// AzureADSettingsResource is the resource implementation.
+type AzureADSettingsResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// AzureADSettingsResourceModel maps the resource schema data.
+type AzureADSettingsResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	ID            types.String `tfsdk:"id"`
+	Enable        types.Bool   `tfsdk:"enable"`
+	ClientID      types.String `tfsdk:"client_id"`
+	ClientSecret  types.String `tfsdk:"client_secret"`
+	GroupFilter   types.String `tfsdk:"group_filter"`
+}
+
+// Metadata returns the resource type name.
+func (r *AzureADSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *AzureADSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Azure AD settings of Ansible Forms. There is a single instance per connection profile, deleting it disables Azure AD authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Connection profile name of the settings.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether Azure AD authentication is enabled. Defaults to true.",
+			},
+			"client_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Client ID of the Azure AD application.",
+			},
+			"client_secret": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Client secret of the Azure AD application.",
+			},
+			"group_filter": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Regular expression selecting the Azure AD groups used by Ansible Forms.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *AzureADSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// settings builds the Azure AD settings from the model.
+func (m *AzureADSettingsResourceModel) settings() interfaces.AzureADSettingsDataModel {
+	return interfaces.AzureADSettingsDataModel{
+		Enable:       m.Enable.ValueBool(),
+		ClientID:     m.ClientID.ValueString(),
+		ClientSecret: m.ClientSecret.ValueString(),
+		GroupFilter:  m.GroupFilter.ValueString(),
+	}
+}
+
+// Create a new resource.
+func (r *AzureADSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AzureADSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.UpdateAzureADSettings(errorHandler, *client, data.settings()); err != nil {
+		return
+	}
+	data.ID = data.CxProfileName
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *AzureADSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AzureADSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	settings, err := interfaces.GetAzureADSettings(errorHandler, *client)
+	if err != nil {
+		return
+	}
+
+	// the client secret is not returned in clear text, keep the one from the state
+	data.ID = data.CxProfileName
+	data.Enable = types.BoolValue(settings.Enable)
+	data.ClientID = types.StringValue(settings.ClientID)
+	data.GroupFilter = types.StringValue(settings.GroupFilter)
+
+	tflog.Debug(ctx, fmt.Sprintf("read Azure AD settings for %s", data.CxProfileName.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *AzureADSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AzureADSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.UpdateAzureADSettings(errorHandler, *client, data.settings()); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete disables Azure AD authentication, the settings are a singleton that cannot be removed.
+func (r *AzureADSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AzureADSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	settings := data.settings()
+	settings.Enable = false
+	if err = interfaces.UpdateAzureADSettings(errorHandler, *client, settings); err != nil {
+		return
+	}
+}
+
+// ImportState imports the Azure AD settings, using the connection profile name as ID.
+func (r *AzureADSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cx_profile_name"), req, resp)
+}
@@ -28,6 +28,8 @@ type Config struct {
 	ConnectionProfiles   map[string]ConnectionProfile
 	Version              string
 	JobCompletionTimeOut int
+	JobProgressInterval  int
+	LogBodies            bool
 }
 
 // GetConnectionProfile retrieves a connection profile based on name
@@ -63,10 +65,11 @@ func (c *Config) NewClient(errorHandler *utils.ErrorHandler, cxProfileName strin
 		return nil, errorHandler.MakeAndReportError("unable to create REST client",
 			fmt.Sprintf("decode error on ConnectionProfile %#v to restclient.ConnectionProfile", connectionProfile))
 	}
+	profile.LogBodies = c.LogBodies
 	// the tag resource_name/version will be used for telemetry
 
 	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("Version string is: %#v", strings.Join([]string{"TerrafromONTAP", resName, c.Version}, "/")))
-	client, err := restclient.NewClient(errorHandler.Ctx, profile, strings.Join([]string{"TerraformONTAP", resName, c.Version}, "/"), c.JobCompletionTimeOut)
+	client, err := restclient.NewClient(errorHandler.Ctx, profile, strings.Join([]string{"TerraformONTAP", resName, c.Version}, "/"), c.JobCompletionTimeOut, c.JobProgressInterval)
 	if err != nil {
 		return nil, errorHandler.MakeAndReportError("unable to create REST client",
 			fmt.Sprintf("error creating REST client: %s", err))
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &CredentialDataSource{}
+
+// CredentialDataSource defines the data source implementation.
+type CredentialDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// NewCredentialDataSource is a helper function to simplify the provider implementation.
+func NewCredentialDataSource() datasource.DataSource {
+	return &CredentialDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "credential",
+		},
+	}
+}
+
+// CredentialDataSourceModel maps the data source schema data.
+type CredentialDataSourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Host          types.String `tfsdk:"host"`
+	User          types.String `tfsdk:"user"`
+	Port          types.Int64  `tfsdk:"port"`
+	Description   types.String `tfsdk:"description"`
+}
+
+// Metadata returns the data source type name.
+func (d *CredentialDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *CredentialDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieves a credential stored in Ansible Forms by name, without its secret.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the credential.",
+				Required:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the credential.",
+				Computed:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host the credential is used for.",
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "User name of the credential.",
+				Computed:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port the credential is used for.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the credential.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *CredentialDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *CredentialDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CredentialDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	credential, err := interfaces.GetCredentialByName(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		// error reporting done inside GetCredentialByName
+		return
+	}
+	if credential == nil {
+		errorHandler.MakeAndReportError("no credential found", fmt.Sprintf("no credential found with name %s", data.Name.ValueString()))
+		return
+	}
+
+	data.ID = types.Int64Value(credential.ID)
+	data.Host = types.StringValue(credential.Host)
+	data.User = types.StringValue(credential.User)
+	data.Port = types.Int64Value(credential.Port)
+	data.Description = types.StringValue(credential.Description)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
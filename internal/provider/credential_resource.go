@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &CredentialResource{}
+	_ resource.ResourceWithConfigure   = &CredentialResource{}
+	_ resource.ResourceWithImportState = &CredentialResource{}
+)
+
+// NewCredentialResource is a helper function to simplify the provider implementation.
+func NewCredentialResource() resource.Resource {
+	return &CredentialResource{
+		config: resourceOrDataSourceConfig{
+			name: "credential",
+		},
+	}
+}
+
+// CredentialResource is the resource implementation.
+type CredentialResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// CredentialResourceModel maps the resource schema data.
+type CredentialResourceModel struct {
+	CxProfileName     types.String `tfsdk:"cx_profile_name"`
+	ID                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Host              types.String `tfsdk:"host"`
+	User              types.String `tfsdk:"user"`
+	Port              types.Int64  `tfsdk:"port"`
+	Description       types.String `tfsdk:"description"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+// Metadata returns the resource type name.
+func (r *CredentialResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *CredentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Credential stored in Ansible Forms, referenced by name in the credentials of a job.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the credential.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the credential.",
+			},
+			"host": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Host the credential is used for.",
+			},
+			"user": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "User name of the credential.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Port the credential is used for.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Description of the credential.",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Secret of the credential, stored in the Terraform state. Prefer `password_wo` with Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Secret of the credential, never stored in the Terraform state (requires Terraform 1.11 or later). Change `password_wo_version` to update it.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of `password_wo`, the secret is only sent when the version changes.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CredentialResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// body builds the request body, with the secret only when it is set.
+func (m *CredentialResourceModel) body(password types.String) interfaces.CredentialResourceBodyDataModel {
+	return interfaces.CredentialResourceBodyDataModel{
+		Name:        m.Name.ValueString(),
+		User:        m.User.ValueString(),
+		Host:        m.Host.ValueString(),
+		Port:        m.Port.ValueInt64(),
+		Description: m.Description.ValueString(),
+		Password:    password.ValueString(),
+	}
+}
+
+// Create a new resource.
+func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CredentialResourceModel
+	var passwordWO types.String
+
+	// Read Terraform plan data into the model, write-only values are only available in the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	password := data.Password
+	if !passwordWO.IsNull() {
+		password = passwordWO
+	}
+	id, err := interfaces.CreateCredential(errorHandler, *client, data.body(password))
+	if err != nil {
+		return
+	}
+	if id == 0 {
+		// some versions do not return the ID of the new credential
+		credential, err := interfaces.GetCredentialByName(errorHandler, *client, data.Name.ValueString())
+		if err != nil {
+			return
+		}
+		if credential == nil {
+			errorHandler.MakeAndReportError("error creating credential", fmt.Sprintf("credential %s not found after creation", data.Name.ValueString()))
+			return
+		}
+		id = credential.ID
+	}
+	data.ID = types.Int64Value(id)
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CredentialResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var credential *interfaces.CredentialGetDataModel
+	if data.ID.IsNull() {
+		// after import by name
+		credential, err = interfaces.GetCredentialByName(errorHandler, *client, data.Name.ValueString())
+	} else {
+		credential, err = interfaces.GetCredentialByID(errorHandler, *client, data.ID.ValueInt64())
+	}
+	if err != nil {
+		return
+	}
+	if credential == nil {
+		tflog.Debug(ctx, fmt.Sprintf("credential %s not found, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.Int64Value(credential.ID)
+	data.Name = types.StringValue(credential.Name)
+	data.Host = types.StringValue(credential.Host)
+	data.User = types.StringValue(credential.User)
+	data.Description = types.StringValue(credential.Description)
+	if credential.Port != 0 || !data.Port.IsNull() {
+		data.Port = types.Int64Value(credential.Port)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a credential resource: %d", credential.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CredentialResourceModel
+	var state *CredentialResourceModel
+	var passwordWO types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	// the secret is not returned by Ansible Forms, only send it when it changes
+	password := types.StringNull()
+	if !data.Password.Equal(state.Password) {
+		password = data.Password
+	} else if !passwordWO.IsNull() && !data.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		password = passwordWO
+	}
+	if err = interfaces.UpdateCredential(errorHandler, *client, state.ID.ValueInt64(), data.body(password)); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.DeleteCredential(errorHandler, *client, data.ID.ValueInt64()); err != nil {
+		return
+	}
+}
+
+// ImportState imports a credential by name, using an ID of the form <name>,<cx_profile_name>.
+func (r *CredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCredentialResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCredentialResourceConfig("first description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_credential.credential", "name", "tf_acc_credential"),
+					resource.TestCheckResourceAttr("ansible-forms_credential.credential", "host", "myhost"),
+					resource.TestCheckResourceAttr("ansible-forms_credential.credential", "user", "myuser"),
+					resource.TestCheckResourceAttr("ansible-forms_credential.credential", "description", "first description"),
+					resource.TestCheckNoResourceAttr("ansible-forms_credential.credential", "password_wo"),
+					resource.TestCheckResourceAttrSet("ansible-forms_credential.credential", "id")),
+			},
+			{
+				Config: testAccCredentialResourceConfig("second description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_credential.credential", "description", "second description")),
+			},
+			{
+				ResourceName:            "ansible-forms_credential.credential",
+				ImportState:             true,
+				ImportStateId:           "tf_acc_credential,cluster4",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_wo_version"},
+			},
+		},
+	})
+}
+
+func testAccCredentialResourceConfig(description string) string {
+	host := os.Getenv("TF_ACC_ANSIBLE_FORMS_HOST")
+	admin := os.Getenv("TF_ACC_ANSIBLE_FORMS_USER")
+	password := os.Getenv("TF_ACC_ANSIBLE_FORMS_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_ANSIBLE_FORMS_HOST, TF_ACC_ANSIBLE_FORMS_USER, and TF_ACC_ANSIBLE_FORMS_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "ansible-forms" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "ansible-forms_credential" "credential" {
+  cx_profile_name     = "cluster4"
+  name                = "tf_acc_credential"
+  host                = "myhost"
+  user                = "myuser"
+  description         = "%s"
+  password_wo         = "mypassword"
+  password_wo_version = 1
+}`, host, admin, password, description)
+}
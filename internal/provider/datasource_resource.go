@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DatasourceResource{}
+	_ resource.ResourceWithConfigure   = &DatasourceResource{}
+	_ resource.ResourceWithImportState = &DatasourceResource{}
+	_ resource.ResourceWithModifyPlan  = &DatasourceResource{}
+)
+
+// NewDatasourceResource is a helper function to simplify the provider implementation.
+func NewDatasourceResource() resource.Resource {
+	return &DatasourceResource{
+		config: resourceOrDataSourceConfig{
+			name: "datasource",
+		},
+	}
+}
+
+// DatasourceResource is the resource implementation.
+type DatasourceResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// DatasourceResourceModel maps the resource schema data.
+type DatasourceResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Form          types.String `tfsdk:"form"`
+	SchemaID      types.Int64  `tfsdk:"schema_id"`
+	Cron          types.String `tfsdk:"cron"`
+	Source        types.String `tfsdk:"source"`
+	RunTriggers   types.Map    `tfsdk:"run_triggers"`
+	JobID         types.Int64  `tfsdk:"job_id"`
+	JobStatus     types.String `tfsdk:"job_status"`
+}
+
+// Metadata returns the resource type name.
+func (r *DatasourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *DatasourceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Datasource of Ansible Forms, a form run to import data into the tables of a datasource schema.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the datasource.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the datasource.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Description of the datasource.",
+			},
+			"form": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the form run to import the data.",
+			},
+			"schema_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the datasource schema the data is imported into, see `ansible-forms_datasource_schema`.",
+			},
+			"cron": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Cron expression to run the import periodically, for instance `0 * * * *`. Empty to disable.",
+			},
+			"source": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "JSON encoded extravars passed to the form, use `jsonencode`.",
+			},
+			"run_triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary map of values that, when set on create or changed, run the import and wait for its job to complete.",
+			},
+			"job_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the job of the last import run by Terraform.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of the job of the last import run by Terraform.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DatasourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// ModifyPlan plans an import when run_triggers changed.
+func (r *DatasourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state *DatasourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !plan.runsImport(state) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("job_id"), types.Int64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("job_status"), types.StringUnknown())...)
+}
+
+// datasource builds the datasource from the model.
+func (m *DatasourceResourceModel) datasource() interfaces.DatasourceDataModel {
+	return interfaces.DatasourceDataModel{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Form:        m.Form.ValueString(),
+		SchemaID:    m.SchemaID.ValueInt64(),
+		Cron:        m.Cron.ValueString(),
+		Source:      m.Source.ValueString(),
+	}
+}
+
+// runsImport tells whether the import runs, when run_triggers is set on create or changed since state.
+func (m *DatasourceResourceModel) runsImport(state *DatasourceResourceModel) bool {
+	if m.RunTriggers.IsNull() {
+		return false
+	}
+	return state == nil || !m.RunTriggers.Equal(state.RunTriggers)
+}
+
+// run runs the import when run_triggers is set on create or changed since state, and records its job.
+func (m *DatasourceResourceModel) run(errorHandler *utils.ErrorHandler, client restclient.RestClient, state *DatasourceResourceModel, options restclient.JobWaitOptions) error {
+	if !m.runsImport(state) {
+		if m.JobID.IsUnknown() {
+			m.JobID = types.Int64Null()
+			m.JobStatus = types.StringNull()
+		}
+		return nil
+	}
+	job, err := interfaces.RunDatasource(errorHandler, client, m.ID.ValueInt64(), options)
+	if err != nil {
+		m.JobID = types.Int64Null()
+		m.JobStatus = types.StringNull()
+		return err
+	}
+	m.JobID = types.Int64Value(job.ID)
+	m.JobStatus = types.StringValue(job.Status)
+
+	return nil
+}
+
+// waitOptions tells how to wait for an import, up to the job completion timeout of the provider.
+func (r *DatasourceResource) waitOptions() restclient.JobWaitOptions {
+	return restclient.JobWaitOptions{
+		Timeout: time.Duration(r.config.providerConfig.JobCompletionTimeOut) * time.Second,
+	}
+}
+
+// Create a new resource.
+func (r *DatasourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DatasourceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	id, err := interfaces.CreateDatasource(errorHandler, *client, data.datasource())
+	if err != nil {
+		return
+	}
+	if id == 0 {
+		// some versions do not return the ID of the new datasource
+		datasource, err := interfaces.GetDatasourceByName(errorHandler, *client, data.Name.ValueString())
+		if err != nil {
+			return
+		}
+		if datasource == nil {
+			errorHandler.MakeAndReportError("error creating datasource", fmt.Sprintf("datasource %s not found after creation", data.Name.ValueString()))
+			return
+		}
+		id = datasource.ID
+	}
+	data.ID = types.Int64Value(id)
+	if err = data.run(errorHandler, *client, nil, r.waitOptions()); err != nil {
+		// keep the datasource in the state so it can be deleted
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *DatasourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DatasourceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var datasource *interfaces.DatasourceDataModel
+	if data.ID.IsNull() {
+		// after import by name
+		datasource, err = interfaces.GetDatasourceByName(errorHandler, *client, data.Name.ValueString())
+	} else {
+		datasource, err = interfaces.GetDatasourceByID(errorHandler, *client, data.ID.ValueInt64())
+	}
+	if err != nil {
+		return
+	}
+	if datasource == nil {
+		tflog.Debug(ctx, fmt.Sprintf("datasource %s not found, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.Int64Value(datasource.ID)
+	data.Name = types.StringValue(datasource.Name)
+	data.Description = types.StringValue(datasource.Description)
+	data.Form = types.StringValue(datasource.Form)
+	data.SchemaID = types.Int64Value(datasource.SchemaID)
+	data.Cron = types.StringValue(datasource.Cron)
+	// keep the configured encoding when the source did not change
+	if !jsonEqual(data.Source.ValueString(), datasource.Source) {
+		data.Source = types.StringValue(datasource.Source)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a datasource resource: %d", datasource.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DatasourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DatasourceResourceModel
+	var state *DatasourceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.UpdateDatasource(errorHandler, *client, state.ID.ValueInt64(), data.datasource()); err != nil {
+		return
+	}
+	data.ID = state.ID
+	if err = data.run(errorHandler, *client, state, r.waitOptions()); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *DatasourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DatasourceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.DeleteDatasource(errorHandler, *client, data.ID.ValueInt64()); err != nil {
+		return
+	}
+}
+
+// ImportState imports a datasource by name, using an ID of the form <name>,<cx_profile_name>.
+func (r *DatasourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
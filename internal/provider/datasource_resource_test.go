@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDatasourceResourceModel_runsImport(t *testing.T) {
+	triggers := func(value string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"version": types.StringValue(value)})
+	}
+	tests := []struct {
+		name  string
+		plan  types.Map
+		state *DatasourceResourceModel
+		want  bool
+	}{
+		{name: "create without triggers", plan: types.MapNull(types.StringType), want: false},
+		{name: "create with triggers", plan: triggers("1"), want: true},
+		{name: "unchanged triggers", plan: triggers("1"), state: &DatasourceResourceModel{RunTriggers: triggers("1")}, want: false},
+		{name: "changed triggers", plan: triggers("2"), state: &DatasourceResourceModel{RunTriggers: triggers("1")}, want: true},
+		{name: "unknown triggers", plan: types.MapUnknown(types.StringType), state: &DatasourceResourceModel{RunTriggers: triggers("1")}, want: true},
+		{name: "removed triggers", plan: types.MapNull(types.StringType), state: &DatasourceResourceModel{RunTriggers: triggers("1")}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &DatasourceResourceModel{RunTriggers: tt.plan}
+			if got := plan.runsImport(tt.state); got != tt.want {
+				t.Errorf("runsImport() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccDatasourceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceResourceConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_datasource_schema.schema", "name", "tf_acc_schema"),
+					resource.TestCheckResourceAttrSet("ansible-forms_datasource_schema.schema", "id"),
+					resource.TestCheckResourceAttr("ansible-forms_datasource.datasource", "name", "tf_acc_datasource"),
+					resource.TestCheckResourceAttrPair("ansible-forms_datasource.datasource", "schema_id", "ansible-forms_datasource_schema.schema", "id"),
+					resource.TestCheckResourceAttr("ansible-forms_datasource.datasource", "job_status", "success")),
+			},
+			{
+				Config: testAccDatasourceResourceConfig("0 * * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_datasource.datasource", "cron", "0 * * * *"),
+					resource.TestCheckResourceAttrSet("ansible-forms_datasource.datasource", "job_id")),
+			},
+			{
+				ResourceName:            "ansible-forms_datasource.datasource",
+				ImportState:             true,
+				ImportStateId:           "tf_acc_datasource,cluster4",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"run_triggers", "job_id", "job_status"},
+			},
+			{
+				ResourceName:      "ansible-forms_datasource_schema.schema",
+				ImportState:       true,
+				ImportStateId:     "tf_acc_schema,cluster4",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDatasourceResourceConfig(cron string) string {
+	host := os.Getenv("TF_ACC_ANSIBLE_FORMS_HOST")
+	admin := os.Getenv("TF_ACC_ANSIBLE_FORMS_USER")
+	password := os.Getenv("TF_ACC_ANSIBLE_FORMS_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_ANSIBLE_FORMS_HOST, TF_ACC_ANSIBLE_FORMS_USER, and TF_ACC_ANSIBLE_FORMS_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "ansible-forms" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "ansible-forms_datasource_schema" "schema" {
+  cx_profile_name   = "cluster4"
+  name              = "tf_acc_schema"
+  table_definitions = <<-EOT
+    tables:
+      - name: volumes
+        columns:
+          - name: name
+            type: varchar(255)
+  EOT
+}
+
+resource "ansible-forms_datasource" "datasource" {
+  cx_profile_name = "cluster4"
+  name            = "tf_acc_datasource"
+  form            = "Demo Form Ansible No input"
+  schema_id       = ansible-forms_datasource_schema.schema.id
+  cron            = "%s"
+  run_triggers = {
+    schema_id = ansible-forms_datasource_schema.schema.id
+  }
+}`, host, admin, password, cron)
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DatasourceSchemaResource{}
+	_ resource.ResourceWithConfigure   = &DatasourceSchemaResource{}
+	_ resource.ResourceWithImportState = &DatasourceSchemaResource{}
+)
+
+// NewDatasourceSchemaResource is a helper function to simplify the provider implementation.
+func NewDatasourceSchemaResource() resource.Resource {
+	return &DatasourceSchemaResource{
+		config: resourceOrDataSourceConfig{
+			name: "datasource_schema",
+		},
+	}
+}
+
+// DatasourceSchemaResource is the resource implementation.
+type DatasourceSchemaResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// DatasourceSchemaResourceModel maps the resource schema data.
+type DatasourceSchemaResourceModel struct {
+	CxProfileName    types.String `tfsdk:"cx_profile_name"`
+	ID               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	TableDefinitions types.String `tfsdk:"table_definitions"`
+}
+
+// Metadata returns the resource type name.
+func (r *DatasourceSchemaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *DatasourceSchemaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Datasource schema of Ansible Forms, the MySQL tables datasources import data into.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the datasource schema.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the datasource schema, also the name of its MySQL database.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Description of the datasource schema.",
+			},
+			"table_definitions": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "YAML definition of the tables of the schema.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DatasourceSchemaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// schema builds the datasource schema from the model.
+func (m *DatasourceSchemaResourceModel) schema() interfaces.DatasourceSchemaDataModel {
+	return interfaces.DatasourceSchemaDataModel{
+		Name:             m.Name.ValueString(),
+		Description:      m.Description.ValueString(),
+		TableDefinitions: m.TableDefinitions.ValueString(),
+	}
+}
+
+// Create a new resource.
+func (r *DatasourceSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DatasourceSchemaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	id, err := interfaces.CreateDatasourceSchema(errorHandler, *client, data.schema())
+	if err != nil {
+		return
+	}
+	if id == 0 {
+		// some versions do not return the ID of the new schema
+		datasourceSchema, err := interfaces.GetDatasourceSchemaByName(errorHandler, *client, data.Name.ValueString())
+		if err != nil {
+			return
+		}
+		if datasourceSchema == nil {
+			errorHandler.MakeAndReportError("error creating datasource schema", fmt.Sprintf("datasource schema %s not found after creation", data.Name.ValueString()))
+			return
+		}
+		id = datasourceSchema.ID
+	}
+	data.ID = types.Int64Value(id)
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *DatasourceSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DatasourceSchemaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	var datasourceSchema *interfaces.DatasourceSchemaDataModel
+	if data.ID.IsNull() {
+		// after import by name
+		datasourceSchema, err = interfaces.GetDatasourceSchemaByName(errorHandler, *client, data.Name.ValueString())
+	} else {
+		datasourceSchema, err = interfaces.GetDatasourceSchemaByID(errorHandler, *client, data.ID.ValueInt64())
+	}
+	if err != nil {
+		return
+	}
+	if datasourceSchema == nil {
+		tflog.Debug(ctx, fmt.Sprintf("datasource schema %s not found, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.Int64Value(datasourceSchema.ID)
+	data.Name = types.StringValue(datasourceSchema.Name)
+	data.Description = types.StringValue(datasourceSchema.Description)
+	data.TableDefinitions = types.StringValue(datasourceSchema.TableDefinitions)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a datasource schema resource: %d", datasourceSchema.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DatasourceSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DatasourceSchemaResourceModel
+	var state *DatasourceSchemaResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.UpdateDatasourceSchema(errorHandler, *client, state.ID.ValueInt64(), data.schema()); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *DatasourceSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DatasourceSchemaResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.DeleteDatasourceSchema(errorHandler, *client, data.ID.ValueInt64()); err != nil {
+		return
+	}
+}
+
+// ImportState imports a datasource schema by name, using an ID of the form <name>,<cx_profile_name>.
+func (r *DatasourceSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
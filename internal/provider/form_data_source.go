@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &FormDataSource{}
+
+// FormDataSource defines the data source implementation.
+type FormDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// NewFormDataSource is a helper function to simplify the provider implementation.
+func NewFormDataSource() datasource.DataSource {
+	return &FormDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "form",
+		},
+	}
+}
+
+// FormDataSourceModel maps the data source schema data.
+type FormDataSourceModel struct {
+	CxProfileName types.String                 `tfsdk:"cx_profile_name"`
+	Name          types.String                 `tfsdk:"name"`
+	Type          types.String                 `tfsdk:"type"`
+	Playbook      types.String                 `tfsdk:"playbook"`
+	Template      types.String                 `tfsdk:"template"`
+	Description   types.String                 `tfsdk:"description"`
+	Categories    []types.String               `tfsdk:"categories"`
+	Roles         []types.String               `tfsdk:"roles"`
+	Approval      *FormDataSourceApprovalModel `tfsdk:"approval"`
+	Fields        []FormDataSourceFieldModel   `tfsdk:"fields"`
+	FieldsJSON    types.String                 `tfsdk:"fields_json"`
+}
+
+// FormDataSourceApprovalModel maps the approval of a form.
+type FormDataSourceApprovalModel struct {
+	Title         types.String   `tfsdk:"title"`
+	Message       types.String   `tfsdk:"message"`
+	Roles         []types.String `tfsdk:"roles"`
+	Notifications []types.String `tfsdk:"notifications"`
+}
+
+// FormDataSourceFieldModel maps a field of a form.
+type FormDataSourceFieldModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Label       types.String `tfsdk:"label"`
+	Description types.String `tfsdk:"description"`
+	Required    types.Bool   `tfsdk:"required"`
+	Default     types.String `tfsdk:"default"`
+	Values      types.String `tfsdk:"values"`
+}
+
+// Metadata returns the data source type name.
+func (d *FormDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *FormDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieves the full definition of a form, including its fields.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the form.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the form, ansible, awx or multistep.",
+				Computed:            true,
+			},
+			"playbook": schema.StringAttribute{
+				MarkdownDescription: "Playbook run by an ansible form.",
+				Computed:            true,
+			},
+			"template": schema.StringAttribute{
+				MarkdownDescription: "AWX template launched by an awx form.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the form.",
+				Computed:            true,
+			},
+			"categories": schema.ListAttribute{
+				MarkdownDescription: "Categories the form is shown in.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"roles": schema.ListAttribute{
+				MarkdownDescription: "Roles allowed to run the form.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"approval": schema.SingleNestedAttribute{
+				MarkdownDescription: "Approval required before a job of the form runs, null if none.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						MarkdownDescription: "Title of the approval request.",
+						Computed:            true,
+					},
+					"message": schema.StringAttribute{
+						MarkdownDescription: "Message of the approval request.",
+						Computed:            true,
+					},
+					"roles": schema.ListAttribute{
+						MarkdownDescription: "Roles allowed to approve a job.",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"notifications": schema.ListAttribute{
+						MarkdownDescription: "Email addresses notified of approval requests.",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+				},
+			},
+			"fields": schema.ListNestedAttribute{
+				MarkdownDescription: "Fields of the form.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of a field, used as extravar.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of a field.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Label of a field.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of a field.",
+							Computed:            true,
+						},
+						"required": schema.BoolAttribute{
+							MarkdownDescription: "Whether a field is required.",
+							Computed:            true,
+						},
+						"default": schema.StringAttribute{
+							MarkdownDescription: "JSON encoded default value of a field, null if none. Use `jsondecode` to read it.",
+							Computed:            true,
+						},
+						"values": schema.StringAttribute{
+							MarkdownDescription: "JSON encoded values a field can take, null if none. Use `jsondecode` to read it.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"fields_json": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded fields of the form, with all their keys.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *FormDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *FormDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FormDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	config, err := interfaces.GetFormConfig(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetFormConfig
+		return
+	}
+	form, err := config.Form(data.Name.ValueString())
+	if err != nil {
+		errorHandler.MakeAndReportError("error reading form", err.Error())
+		return
+	}
+	if form == nil {
+		errorHandler.MakeAndReportError("no form found", fmt.Sprintf("no form found with name %s", data.Name.ValueString()))
+		return
+	}
+	fields, err := form.FormFields()
+	if err != nil {
+		errorHandler.MakeAndReportError("error reading form fields", err.Error())
+		return
+	}
+
+	data.Type = types.StringValue(form.Type)
+	data.Playbook = types.StringValue(form.Playbook)
+	data.Template = types.StringValue(form.Template)
+	data.Description = types.StringValue(form.Description)
+	data.Categories = flattenTypesStringList(form.Categories)
+	data.Roles = flattenTypesStringList(form.Roles)
+	if form.Approval != nil {
+		data.Approval = &FormDataSourceApprovalModel{
+			Title:         types.StringValue(form.Approval.Title),
+			Message:       types.StringValue(form.Approval.Message),
+			Roles:         flattenTypesStringList(form.Approval.Roles),
+			Notifications: flattenTypesStringList(form.Approval.Notifications),
+		}
+	}
+	data.Fields = make([]FormDataSourceFieldModel, len(fields))
+	for index, field := range fields {
+		data.Fields[index] = FormDataSourceFieldModel{
+			Name:        types.StringValue(field.Name),
+			Type:        types.StringValue(field.Type),
+			Label:       types.StringValue(field.Label),
+			Description: types.StringValue(field.Description),
+			Required:    types.BoolValue(field.Required),
+		}
+		if data.Fields[index].Default, err = jsonStringOrNull(field.Default); err == nil {
+			data.Fields[index].Values, err = jsonStringOrNull(field.Values)
+		}
+		if err != nil {
+			errorHandler.MakeAndReportError("error encoding form field", fmt.Sprintf("field %s: %s", field.Name, err))
+			return
+		}
+	}
+	if data.FieldsJSON, err = jsonStringOrNull(form.Fields); err != nil {
+		errorHandler.MakeAndReportError("error encoding form fields", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: form %s", form.Name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,448 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &FormResource{}
+	_ resource.ResourceWithConfigure      = &FormResource{}
+	_ resource.ResourceWithImportState    = &FormResource{}
+	_ resource.ResourceWithValidateConfig = &FormResource{}
+	_ resource.ResourceWithModifyPlan     = &FormResource{}
+)
+
+// NewFormResource is a helper function to simplify the provider implementation.
+func NewFormResource() resource.Resource {
+	return &FormResource{
+		config: resourceOrDataSourceConfig{
+			name: "form",
+		},
+	}
+}
+
+// FormResource is the resource implementation.
+type FormResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// FormResourceModel maps the resource schema data.
+type FormResourceModel struct {
+	CxProfileName types.String               `tfsdk:"cx_profile_name"`
+	ID            types.String               `tfsdk:"id"`
+	Name          types.String               `tfsdk:"name"`
+	Type          types.String               `tfsdk:"type"`
+	Playbook      types.String               `tfsdk:"playbook"`
+	Template      types.String               `tfsdk:"template"`
+	Description   types.String               `tfsdk:"description"`
+	Categories    types.List                 `tfsdk:"categories"`
+	Roles         types.List                 `tfsdk:"roles"`
+	Approval      *FormApprovalResourceModel `tfsdk:"approval"`
+	Fields        jsontypes.Normalized       `tfsdk:"fields"`
+}
+
+// FormApprovalResourceModel maps the approval of a form.
+type FormApprovalResourceModel struct {
+	Title         types.String `tfsdk:"title"`
+	Message       types.String `tfsdk:"message"`
+	Roles         types.List   `tfsdk:"roles"`
+	Notifications types.List   `tfsdk:"notifications"`
+}
+
+// Metadata returns the resource type name.
+func (r *FormResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *FormResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Form of the Ansible Forms configuration. Settings of the form not managed by this resource are kept.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the form.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the form, used as form_name by jobs.",
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Type of the form, one of ansible, awx or multistep.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(interfaces.FormTypes...),
+				},
+			},
+			"playbook": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Playbook run by an ansible form.",
+			},
+			"template": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "AWX template launched by an awx form.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the form.",
+			},
+			"categories": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Categories the form is shown in.",
+			},
+			"roles": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Roles allowed to run the form.",
+			},
+			"approval": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Approval required before a job of the form runs.",
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Title of the approval request.",
+					},
+					"message": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Message of the approval request.",
+					},
+					"roles": schema.ListAttribute{
+						Required:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Roles allowed to approve a job.",
+					},
+					"notifications": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Email addresses notified of approval requests.",
+					},
+				},
+			},
+			"fields": schema.StringAttribute{
+				Required:            true,
+				CustomType:          jsontypes.NormalizedType{},
+				MarkdownDescription: "JSON encoded list of fields of the form, use `jsonencode`. Each field is an object with at least a unique `name` and a `type`. Differences in whitespace or key order are not changes.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *FormResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// ValidateConfig checks the structure of the form at plan time.
+func (r *FormResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FormResourceModel
+
+	// only read the validated attributes, others may be unknown
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &data.Type)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("playbook"), &data.Playbook)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("template"), &data.Template)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("fields"), &data.Fields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch data.Type.ValueString() {
+	case "ansible":
+		if data.Playbook.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("playbook"), "Missing playbook", "playbook is required for a form of type ansible.")
+		}
+	case "awx":
+		if data.Template.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("template"), "Missing template", "template is required for a form of type awx.")
+		}
+	}
+
+	if data.Fields.IsUnknown() || data.Fields.IsNull() {
+		return
+	}
+	var fields []any
+	if err := json.Unmarshal([]byte(data.Fields.ValueString()), &fields); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid fields", fmt.Sprintf("fields must be a JSON encoded list: %s", err))
+		return
+	}
+	if err := interfaces.ValidateFormFields(fields); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid fields", err.Error())
+	}
+}
+
+// apply sets the attributes managed by the resource on form, keeping the other settings of the form.
+func (m *FormResourceModel) apply(ctx context.Context, diags *diag.Diagnostics, form map[string]any) map[string]any {
+	updated := make(map[string]any, len(form))
+	for k, v := range form {
+		updated[k] = v
+	}
+	setOrDelete := func(key string, value types.String) {
+		if value.IsNull() {
+			delete(updated, key)
+		} else {
+			updated[key] = value.ValueString()
+		}
+	}
+	setListOrDelete := func(key string, value types.List) {
+		if value.IsNull() {
+			delete(updated, key)
+			return
+		}
+		var list []string
+		diags.Append(value.ElementsAs(ctx, &list, false)...)
+		updated[key] = list
+	}
+
+	updated["name"] = m.Name.ValueString()
+	updated["type"] = m.Type.ValueString()
+	setOrDelete("playbook", m.Playbook)
+	setOrDelete("template", m.Template)
+	setOrDelete("description", m.Description)
+	setListOrDelete("categories", m.Categories)
+	setListOrDelete("roles", m.Roles)
+	if m.Approval == nil {
+		delete(updated, "approval")
+	} else {
+		approval := map[string]any{"title": m.Approval.Title.ValueString()}
+		if !m.Approval.Message.IsNull() {
+			approval["message"] = m.Approval.Message.ValueString()
+		}
+		var roles, notifications []string
+		diags.Append(m.Approval.Roles.ElementsAs(ctx, &roles, false)...)
+		approval["roles"] = roles
+		if !m.Approval.Notifications.IsNull() {
+			diags.Append(m.Approval.Notifications.ElementsAs(ctx, &notifications, false)...)
+			approval["notifications"] = notifications
+		}
+		updated["approval"] = approval
+	}
+	var fields []any
+	if err := json.Unmarshal([]byte(m.Fields.ValueString()), &fields); err != nil {
+		diags.AddError("Invalid fields", fmt.Sprintf("fields must be a JSON encoded list: %s", err))
+	}
+	updated["fields"] = fields
+
+	return updated
+}
+
+// ModifyPlan plans the id from the name, as the id of a form is its name and a form is renamed in place.
+func (r *FormResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), name)...)
+}
+
+// Create a new resource.
+func (r *FormResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FormResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	name := data.Name.ValueString()
+	err = interfaces.UpdateFormConfig(errorHandler, *client, func(config *interfaces.FormConfig) error {
+		if config.RawForm(name) != nil {
+			return fmt.Errorf("form %s already exists, import it to manage it", name)
+		}
+		config.SetForm(name, data.apply(ctx, &resp.Diagnostics, nil))
+		return nil
+	})
+	if err != nil || resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = types.StringValue(name)
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *FormResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FormResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	config, err := interfaces.GetFormConfig(errorHandler, *client)
+	if err != nil {
+		return
+	}
+	form, err := config.Form(data.Name.ValueString())
+	if err != nil {
+		errorHandler.MakeAndReportError("error reading form", err.Error())
+		return
+	}
+	if form == nil {
+		tflog.Debug(ctx, fmt.Sprintf("form %s not found, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(form.Name)
+	data.Type = types.StringValue(form.Type)
+	data.Playbook = stringOrNull(form.Playbook, data.Playbook)
+	data.Template = stringOrNull(form.Template, data.Template)
+	data.Description = stringOrNull(form.Description, data.Description)
+	data.Categories = stringListOrNull(ctx, &resp.Diagnostics, form.Categories, data.Categories)
+	data.Roles = stringListOrNull(ctx, &resp.Diagnostics, form.Roles, data.Roles)
+	if form.Approval == nil {
+		data.Approval = nil
+	} else {
+		var current FormApprovalResourceModel
+		if data.Approval != nil {
+			current = *data.Approval
+		}
+		data.Approval = &FormApprovalResourceModel{
+			Title:         types.StringValue(form.Approval.Title),
+			Message:       stringOrNull(form.Approval.Message, current.Message),
+			Roles:         stringListOrNull(ctx, &resp.Diagnostics, form.Approval.Roles, types.ListValueMust(types.StringType, nil)),
+			Notifications: stringListOrNull(ctx, &resp.Diagnostics, form.Approval.Notifications, current.Notifications),
+		}
+	}
+
+	fields, err := json.Marshal(form.Fields)
+	if err != nil {
+		errorHandler.MakeAndReportError("error encoding form fields", err.Error())
+		return
+	}
+	// the configured encoding is kept when the fields did not change, as fields are compared as JSON values
+	data.Fields = jsontypes.NewNormalizedValue(string(fields))
+
+	tflog.Debug(ctx, fmt.Sprintf("read a form resource: %s", form.Name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *FormResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FormResourceModel
+	var state *FormResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	name := data.Name.ValueString()
+	err = interfaces.UpdateFormConfig(errorHandler, *client, func(config *interfaces.FormConfig) error {
+		if name != state.Name.ValueString() && config.RawForm(name) != nil {
+			return fmt.Errorf("cannot rename form %s, form %s already exists", state.Name.ValueString(), name)
+		}
+		config.SetForm(state.Name.ValueString(), data.apply(ctx, &resp.Diagnostics, config.RawForm(state.Name.ValueString())))
+		return nil
+	})
+	if err != nil || resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = types.StringValue(name)
+
+	tflog.Trace(ctx, "updated a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *FormResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FormResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	_ = interfaces.UpdateFormConfig(errorHandler, *client, func(config *interfaces.FormConfig) error {
+		config.RemoveForm(data.Name.ValueString())
+		return nil
+	})
+}
+
+// ImportState imports a form by name, using an ID of the form <name>,<cx_profile_name>.
+func (r *FormResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
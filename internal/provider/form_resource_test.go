@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFormResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFormResourceConfig(`[{ name = "share_name", type = "text", label = "Share name" }]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_form.form", "name", "TF Acc Form"),
+					resource.TestCheckResourceAttr("ansible-forms_form.form", "type", "ansible"),
+					resource.TestCheckResourceAttr("ansible-forms_form.form", "categories.0", "Default"),
+					resource.TestCheckResourceAttr("ansible-forms_form.form", "id", "TF Acc Form")),
+			},
+			{
+				Config: testAccFormResourceConfig(`[{ name = "share_name", type = "text", label = "Share name" }, { name = "size", type = "number", label = "Size", default = 10 }]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("ansible-forms_form.form", "fields", regexp.MustCompile(`"size"`))),
+			},
+			{
+				ResourceName:      "ansible-forms_form.form",
+				ImportState:       true,
+				ImportStateId:     "TF Acc Form,cluster4",
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccFormResourceConfig(`[{ name = "share_name", type = "text" }, { name = "share_name", type = "text" }]`),
+				ExpectError: regexp.MustCompile("field share_name is defined more than once"),
+			},
+		},
+	})
+}
+
+func testAccFormResourceConfig(fields string) string {
+	host := os.Getenv("TF_ACC_ANSIBLE_FORMS_HOST")
+	admin := os.Getenv("TF_ACC_ANSIBLE_FORMS_USER")
+	password := os.Getenv("TF_ACC_ANSIBLE_FORMS_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_ANSIBLE_FORMS_HOST, TF_ACC_ANSIBLE_FORMS_USER, and TF_ACC_ANSIBLE_FORMS_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "ansible-forms" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "ansible-forms_form" "form" {
+  cx_profile_name = "cluster4"
+  name            = "TF Acc Form"
+  type            = "ansible"
+  playbook        = "dummy.yaml"
+  categories      = ["Default"]
+  roles           = ["admin"]
+  fields          = jsonencode(%s)
+}`, host, admin, password, fields)
+}
+
+func TestFormResource_ModifyPlan(t *testing.T) {
+	tests := []struct {
+		name   string
+		planID types.String
+		want   types.String
+	}{
+		{name: "renamed", planID: types.StringValue("old name"), want: types.StringValue("new name")},
+		{name: "unknown id", planID: types.StringUnknown(), want: types.StringValue("new name")},
+	}
+	ctx := context.Background()
+	r := NewFormResource().(*FormResource)
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planModel := FormResourceModel{
+				CxProfileName: types.StringValue("cluster4"),
+				ID:            tt.planID,
+				Name:          types.StringValue("new name"),
+				Type:          types.StringValue("ansible"),
+				Playbook:      types.StringValue("dummy.yaml"),
+				Template:      types.StringNull(),
+				Description:   types.StringNull(),
+				Categories:    types.ListNull(types.StringType),
+				Roles:         types.ListNull(types.StringType),
+				Fields:        jsontypes.NewNormalizedNull(),
+			}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, &planModel); diags.HasError() {
+				t.Fatalf("Plan.Set() diagnostics: %v", diags)
+			}
+
+			resp := fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics: %v", resp.Diagnostics)
+			}
+			var got FormResourceModel
+			if diags := resp.Plan.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("Plan.Get() diagnostics: %v", diags)
+			}
+			if !got.ID.Equal(tt.want) {
+				t.Errorf("ModifyPlan() id = %v, want %v", got.ID, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &FormsDataSource{}
+
+// FormsDataSource defines the data source implementation.
+type FormsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// NewFormsDataSource is a helper function to simplify the provider implementation.
+func NewFormsDataSource() datasource.DataSource {
+	return &FormsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "forms",
+		},
+	}
+}
+
+// FormsDataSourceModel maps the data source schema data.
+type FormsDataSourceModel struct {
+	CxProfileName types.String                  `tfsdk:"cx_profile_name"`
+	Category      types.String                  `tfsdk:"category"`
+	Forms         []FormsDataSourceEntry        `tfsdk:"forms"`
+	Categories    []FormsDataSourceCategoryItem `tfsdk:"categories"`
+	Roles         []FormsDataSourceRoleItem     `tfsdk:"roles"`
+}
+
+// FormsDataSourceEntry maps a form of the list.
+type FormsDataSourceEntry struct {
+	Name        types.String   `tfsdk:"name"`
+	Type        types.String   `tfsdk:"type"`
+	Description types.String   `tfsdk:"description"`
+	Categories  []types.String `tfsdk:"categories"`
+	Roles       []types.String `tfsdk:"roles"`
+}
+
+// FormsDataSourceCategoryItem maps a category of the forms configuration.
+type FormsDataSourceCategoryItem struct {
+	Name types.String `tfsdk:"name"`
+	Icon types.String `tfsdk:"icon"`
+}
+
+// FormsDataSourceRoleItem maps a role of the forms configuration.
+type FormsDataSourceRoleItem struct {
+	Name   types.String   `tfsdk:"name"`
+	Groups []types.String `tfsdk:"groups"`
+	Users  []types.String `tfsdk:"users"`
+}
+
+// Metadata returns the data source type name.
+func (d *FormsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *FormsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the forms, categories and roles of the forms configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "Only list forms of this category.",
+				Optional:            true,
+			},
+			"forms": schema.ListNestedAttribute{
+				MarkdownDescription: "Forms of the configuration.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of a form.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of a form, ansible, awx or multistep.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of a form.",
+							Computed:            true,
+						},
+						"categories": schema.ListAttribute{
+							MarkdownDescription: "Categories a form is shown in.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"roles": schema.ListAttribute{
+							MarkdownDescription: "Roles allowed to run a form.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"categories": schema.ListNestedAttribute{
+				MarkdownDescription: "Categories of the configuration.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of a category.",
+							Computed:            true,
+						},
+						"icon": schema.StringAttribute{
+							MarkdownDescription: "Icon of a category.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"roles": schema.ListNestedAttribute{
+				MarkdownDescription: "Roles of the configuration.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of a role.",
+							Computed:            true,
+						},
+						"groups": schema.ListAttribute{
+							MarkdownDescription: "Groups of a role.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"users": schema.ListAttribute{
+							MarkdownDescription: "Users of a role.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *FormsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *FormsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FormsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	config, err := interfaces.GetFormConfig(errorHandler, *client)
+	if err != nil {
+		// error reporting done inside GetFormConfig
+		return
+	}
+	forms, err := config.Forms()
+	if err != nil {
+		errorHandler.MakeAndReportError("error reading forms", err.Error())
+		return
+	}
+	categories, err := config.Categories()
+	if err != nil {
+		errorHandler.MakeAndReportError("error reading form categories", err.Error())
+		return
+	}
+	roles, err := config.Roles()
+	if err != nil {
+		errorHandler.MakeAndReportError("error reading form roles", err.Error())
+		return
+	}
+
+	data.Forms = []FormsDataSourceEntry{}
+	for _, form := range forms {
+		if !data.Category.IsNull() && !slices.Contains(form.Categories, data.Category.ValueString()) {
+			continue
+		}
+		data.Forms = append(data.Forms, FormsDataSourceEntry{
+			Name:        types.StringValue(form.Name),
+			Type:        types.StringValue(form.Type),
+			Description: types.StringValue(form.Description),
+			Categories:  flattenTypesStringList(form.Categories),
+			Roles:       flattenTypesStringList(form.Roles),
+		})
+	}
+
+	data.Categories = make([]FormsDataSourceCategoryItem, len(categories))
+	for index, category := range categories {
+		data.Categories[index] = FormsDataSourceCategoryItem{
+			Name: types.StringValue(category.Name),
+			Icon: types.StringValue(category.Icon),
+		}
+	}
+	data.Roles = make([]FormsDataSourceRoleItem, len(roles))
+	for index, role := range roles {
+		data.Roles[index] = FormsDataSourceRoleItem{
+			Name:   types.StringValue(role.Name),
+			Groups: flattenTypesStringList(role.Groups),
+			Users:  flattenTypesStringList(role.Users),
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %d forms", len(data.Forms)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
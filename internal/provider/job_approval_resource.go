@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microcosm-cc/bluemonday"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &JobApprovalResource{}
+	_ resource.ResourceWithConfigure = &JobApprovalResource{}
+)
+
+// NewJobApprovalResource is a helper function to simplify the provider implementation.
+func NewJobApprovalResource() resource.Resource {
+	return &JobApprovalResource{
+		config: resourceOrDataSourceConfig{
+			name: "job_approval",
+		},
+	}
+}
+
+// JobApprovalResource is the resource implementation.
+type JobApprovalResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// JobApprovalResourceModel maps the resource schema data.
+type JobApprovalResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	JobID         types.Int64  `tfsdk:"job_id"`
+	Decision      types.String `tfsdk:"decision"`
+	ID            types.Int64  `tfsdk:"id"`
+	LastUpdated   types.String `tfsdk:"last_updated"`
+	Status        types.String `tfsdk:"status"`
+	Output        types.String `tfsdk:"output"`
+	Start         types.String `tfsdk:"start"`
+	End           types.String `tfsdk:"end"`
+	Approval      types.Object `tfsdk:"approval"`
+}
+
+// Metadata returns the resource type name.
+func (r *JobApprovalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *JobApprovalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Approves or rejects a job pending approval. Destroying the resource does not revert the decision.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+			},
+			"job_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the job pending approval.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"decision": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Decision to apply to the job, one of approve or reject.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"approve", "reject"}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the job.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the decision.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of the job after the decision.",
+			},
+			"output": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Output of the job.",
+			},
+			"start": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Start time of the job.",
+			},
+			"end": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "End time of the job.",
+			},
+			"approval": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Approval of the job.",
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Title of the approval request.",
+					},
+					"message": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Message of the approval request.",
+					},
+					"roles": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Roles allowed to approve the job.",
+					},
+					"approved_by": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "User who approved or rejected the job.",
+					},
+					"approved_at": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Time of the approval or rejection.",
+					},
+					"decision": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Approval decision, one of pending, approved or rejected.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *JobApprovalResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Create approves or rejects the job.
+func (r *JobApprovalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *JobApprovalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	jobID := data.JobID.ValueInt64()
+	job, err := interfaces.GetJobByID(errorHandler, *client, jobID)
+	if err != nil {
+		return
+	}
+	if job.Status != restclient.AnsibleJobStatusApprove {
+		errorHandler.MakeAndReportError("job is not pending approval", fmt.Sprintf("job %d has status %s", jobID, job.Status))
+		return
+	}
+
+	if data.Decision.ValueString() == "reject" {
+		if err = interfaces.RejectJob(errorHandler, *client, jobID); err != nil {
+			return
+		}
+		job, err = interfaces.GetJobByID(errorHandler, *client, jobID)
+	} else {
+		if err = interfaces.ApproveJob(errorHandler, *client, jobID); err != nil {
+			return
+		}
+		// the job may still report the approve status for a moment after the approval
+		timeout := time.Duration(r.config.providerConfig.JobCompletionTimeOut) * time.Second
+		job, err = interfaces.AwaitJob(errorHandler, *client, jobID, restclient.JobWaitOptions{ApprovalTimeout: timeout, Timeout: timeout})
+	}
+	var jobErr *interfaces.JobError
+	if err != nil && !errors.As(err, &jobErr) {
+		return
+	}
+
+	data.ID = types.Int64Value(jobID)
+	data.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	r.setJobAttributes(ctx, data, job, &resp.Diagnostics)
+	if jobErr != nil {
+		// the decision is applied and cannot be made again, an error would taint the resource so the job error is a warning
+		resp.Diagnostics.AddWarning("job approved but did not complete successfully", jobErr.Error())
+		tflog.Warn(ctx, fmt.Sprintf("job %d approved but did not complete successfully: %s", jobID, jobErr))
+	}
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the status and output of the job.
+func (r *JobApprovalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *JobApprovalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	job, err := interfaces.FindJobByID(errorHandler, *client, data.ID.ValueInt64())
+	if err != nil {
+		return
+	}
+	if job == nil {
+		tflog.Debug(ctx, fmt.Sprintf("job %d not found, removing its approval from state", data.ID.ValueInt64()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.setJobAttributes(ctx, data, job, &resp.Diagnostics)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a job approval resource: %#v", data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only applies to the connection profile, the decision cannot be changed in place.
+func (r *JobApprovalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *JobApprovalResourceModel
+	var state *JobApprovalResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Status = state.Status
+	data.Output = state.Output
+	data.Start = state.Start
+	data.End = state.End
+	data.Approval = state.Approval
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from the Terraform state, a decision cannot be reverted.
+func (r *JobApprovalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *JobApprovalResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("job %d approval removed from state, the %s decision is kept", data.ID.ValueInt64(), data.Decision.ValueString()))
+}
+
+// setJobAttributes copies the job status and output to the model.
+func (r *JobApprovalResource) setJobAttributes(ctx context.Context, data *JobApprovalResourceModel, job *interfaces.JobGetDataSourceModel, diags *diag.Diagnostics) {
+	data.Status = types.StringValue(job.Status)
+	data.Output = types.StringValue(html.UnescapeString(bluemonday.StrictPolicy().Sanitize(job.Output)))
+	data.Start = types.StringValue(job.Start)
+	data.End = types.StringValue(job.End)
+	data.Approval = flattenJobApproval(ctx, diags, job)
+}
@@ -0,0 +1,509 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microcosm-cc/bluemonday"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &JobBatchResource{}
+	_ resource.ResourceWithConfigure  = &JobBatchResource{}
+	_ resource.ResourceWithModifyPlan = &JobBatchResource{}
+)
+
+// jobBatchSkipped is the status of an item whose job was not launched after an earlier failure.
+const jobBatchSkipped = "skipped"
+
+// jobBatchRerunStatuses are the statuses of items whose job runs again on the next apply.
+var jobBatchRerunStatuses = []string{restclient.AnsibleStatusFailure, restclient.AnsibleJobStatusRejected, jobBatchSkipped}
+
+// jobBatchJobAttrTypes describes the job of an item of a batch.
+var jobBatchJobAttrTypes = map[string]attr.Type{
+	"id":        types.Int64Type,
+	"status":    types.StringType,
+	"output":    types.StringType,
+	"error":     types.StringType,
+	"extravars": types.MapType{ElemType: types.StringType},
+}
+
+// NewJobBatchResource is a helper function to simplify the provider implementation.
+func NewJobBatchResource() resource.Resource {
+	return &JobBatchResource{
+		config: resourceOrDataSourceConfig{
+			name: "job_batch",
+		},
+	}
+}
+
+// JobBatchResource is the resource implementation.
+type JobBatchResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// JobBatchResourceModel maps the resource schema data.
+type JobBatchResourceModel struct {
+	CxProfileName      types.String `tfsdk:"cx_profile_name"`
+	ID                 types.String `tfsdk:"id"`
+	FormName           types.String `tfsdk:"form_name"`
+	Items              types.Map    `tfsdk:"items"`
+	Credentials        types.Map    `tfsdk:"credentials"`
+	MaxParallel        types.Int64  `tfsdk:"max_parallel"`
+	StopOnFirstFailure types.Bool   `tfsdk:"stop_on_first_failure"`
+	Jobs               types.Map    `tfsdk:"jobs"`
+}
+
+// JobBatchJobModel maps the job of an item of a batch.
+type JobBatchJobModel struct {
+	ID        types.Int64       `tfsdk:"id"`
+	Status    types.String      `tfsdk:"status"`
+	Output    types.String      `tfsdk:"output"`
+	Error     types.String      `tfsdk:"error"`
+	Extravars map[string]string `tfsdk:"extravars"`
+}
+
+// Metadata returns the resource type name.
+func (r *JobBatchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *JobBatchResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Batch of jobs running the same form for each set of extra vars, with a bounded number of jobs running at the same time.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Form name of the batch.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"form_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Form name of the jobs.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"items": schema.MapAttribute{
+				Required:            true,
+				ElementType:         types.MapType{ElemType: types.StringType},
+				MarkdownDescription: "Extra vars of each job, keyed by a stable item name. A job runs for new and changed items, and with `state` set to `absent` for removed items.",
+			},
+			"credentials": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Sensitive:           true,
+				MarkdownDescription: "Credentials of the jobs.",
+			},
+			"max_parallel": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(5),
+				MarkdownDescription: "Maximum number of jobs running at the same time. Defaults to 5.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"stop_on_first_failure": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to launch no more jobs once a job failed, the remaining items get status `skipped`. Defaults to false.",
+			},
+			"jobs": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Last job of each item, keyed by item name. Items with status `error`, `rejected` or `skipped` run again on the next apply.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the job, null when the job was skipped.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Status of the job.",
+						},
+						"output": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Output of the job.",
+						},
+						"error": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Error of the job.",
+						},
+						"extravars": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Extra vars the job ran with.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *JobBatchResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// items returns the extra vars of each item.
+func (m *JobBatchResourceModel) items(ctx context.Context, diags *diag.Diagnostics) map[string]map[string]string {
+	items := map[string]map[string]string{}
+	diags.Append(m.Items.ElementsAs(ctx, &items, false)...)
+	return items
+}
+
+// jobs returns the last job of each item.
+func (m *JobBatchResourceModel) jobs(ctx context.Context, diags *diag.Diagnostics) map[string]JobBatchJobModel {
+	jobs := map[string]JobBatchJobModel{}
+	if m.Jobs.IsNull() || m.Jobs.IsUnknown() {
+		return jobs
+	}
+	diags.Append(m.Jobs.ElementsAs(ctx, &jobs, false)...)
+	return jobs
+}
+
+// setJobs sets the last job of each item.
+func (m *JobBatchResourceModel) setJobs(ctx context.Context, diags *diag.Diagnostics, jobs map[string]JobBatchJobModel) {
+	value, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: jobBatchJobAttrTypes}, jobs)
+	diags.Append(d...)
+	m.Jobs = value
+}
+
+// jobBatchChanges returns the items whose job must run, and the items removed since their last job, sorted by name.
+func jobBatchChanges(items map[string]map[string]string, jobs map[string]JobBatchJobModel) ([]string, []string) {
+	var toRun, toRemove []string
+	for key, extravars := range items {
+		job, ok := jobs[key]
+		if !ok || !maps.Equal(job.Extravars, extravars) || slices.Contains(jobBatchRerunStatuses, job.Status.ValueString()) {
+			toRun = append(toRun, key)
+		}
+	}
+	for key, job := range jobs {
+		if _, ok := items[key]; !ok && job.Status.ValueString() != jobBatchSkipped {
+			toRemove = append(toRemove, key)
+		}
+	}
+	slices.Sort(toRun)
+	slices.Sort(toRemove)
+
+	return toRun, toRemove
+}
+
+// request builds the job of an item.
+func (m *JobBatchResourceModel) request(extravars map[string]string, state string) interfaces.JobResourceModel {
+	request := interfaces.JobResourceModel{
+		Form:          m.FormName.ValueString(),
+		CxProfileName: m.CxProfileName.ValueString(),
+		State:         state,
+		Extravars:     map[string]interface{}{},
+	}
+	for k, v := range extravars {
+		request.Extravars[k] = v
+	}
+	request.Extravars["state"] = state
+	if !m.Credentials.IsNull() {
+		request.Credentials = map[string]interface{}{}
+		for k, v := range m.Credentials.Elements() {
+			request.Credentials[k] = v
+		}
+	}
+
+	return request
+}
+
+// secrets returns the credential values, masked in the logs and in the outputs and errors of the jobs.
+func (m *JobBatchResourceModel) secrets() []string {
+	var secrets []string
+	for _, value := range m.Credentials.Elements() {
+		if s, ok := value.(types.String); ok && s.ValueString() != "" {
+			secrets = append(secrets, s.ValueString())
+		}
+	}
+	return secrets
+}
+
+// maskSecrets masks the secrets in an error of a job.
+func (m *JobBatchResourceModel) maskSecrets(value string) string {
+	for _, secret := range m.secrets() {
+		value = strings.ReplaceAll(value, secret, "***")
+	}
+	return value
+}
+
+// maskOutput sanitizes the output of a job and masks the secrets in it.
+func (m *JobBatchResourceModel) maskOutput(output string) string {
+	return m.maskSecrets(html.UnescapeString(bluemonday.StrictPolicy().Sanitize(output)))
+}
+
+// maskDiags masks the secrets in the diagnostics of the job of an item, which may include the output of the job.
+func (m *JobBatchResourceModel) maskDiags(itemDiags diag.Diagnostics) diag.Diagnostics {
+	masked := make(diag.Diagnostics, 0, len(itemDiags))
+	for _, d := range itemDiags {
+		if d.Severity() == diag.SeverityError {
+			masked.AddError(d.Summary(), m.maskSecrets(d.Detail()))
+			continue
+		}
+		masked.AddWarning(d.Summary(), m.maskSecrets(d.Detail()))
+	}
+	return masked
+}
+
+// ModifyPlan plans the jobs when items must run, including items whose last job failed or was skipped.
+func (r *JobBatchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state *JobBatchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Items.IsUnknown() {
+		return
+	}
+	toRun, toRemove := jobBatchChanges(plan.items(ctx, &resp.Diagnostics), state.jobs(ctx, &resp.Diagnostics))
+	if len(toRun) == 0 && len(toRemove) == 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("jobs"), state.Jobs)...)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("jobs to run: %v, jobs to remove: %v", toRun, toRemove))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("jobs"), types.MapUnknown(types.ObjectType{AttrTypes: jobBatchJobAttrTypes}))...)
+}
+
+// run runs the jobs of new, changed and failed items, and of removed items with state absent.
+// The jobs of the items are updated even when some jobs fail, the failures are reported as warnings when failuresAsWarnings is set,
+// so that the failed items run again on the next apply.
+func (r *JobBatchResource) run(ctx context.Context, diags *diag.Diagnostics, data *JobBatchResourceModel, jobs map[string]JobBatchJobModel, failuresAsWarnings bool) {
+	ctx = restclient.MaskStrings(ctx, data.secrets()...)
+	errorHandler := utils.NewErrorHandler(ctx, diags)
+	items := data.items(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+	toRun, toRemove := jobBatchChanges(items, jobs)
+	if len(toRun) == 0 && len(toRemove) == 0 {
+		data.setJobs(ctx, diags, jobs)
+		return
+	}
+
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	batch := make([]interfaces.JobBatchItem, 0, len(toRun)+len(toRemove))
+	for _, key := range toRemove {
+		batch = append(batch, interfaces.JobBatchItem{Key: key, Data: data.request(jobs[key].Extravars, "absent")})
+	}
+	for _, key := range toRun {
+		batch = append(batch, interfaces.JobBatchItem{Key: key, Data: data.request(items[key], "present")})
+	}
+	timeout := time.Duration(r.config.providerConfig.JobCompletionTimeOut) * time.Second
+	results := interfaces.RunJobBatch(errorHandler, *client, batch, interfaces.JobBatchOptions{
+		MaxParallel:        int(data.MaxParallel.ValueInt64()),
+		StopOnFirstFailure: data.StopOnFirstFailure.ValueBool(),
+		Wait:               restclient.JobWaitOptions{ApprovalTimeout: timeout, Timeout: timeout},
+	})
+
+	for _, result := range results {
+		itemDiags := result.Diags
+		var jobErr *interfaces.JobError
+		if errors.As(result.Err, &jobErr) {
+			itemDiags.AddError(fmt.Sprintf("error running job for item %s", result.Key), jobErr.Error())
+		}
+		itemDiags = data.maskDiags(itemDiags)
+		reportJobBatchItemDiags(ctx, diags, itemDiags, failuresAsWarnings)
+		_, removed := items[result.Key]
+		removed = !removed
+		switch {
+		case result.Skipped && removed:
+			// keep the last job, the item is removed on the next apply
+		case result.Skipped:
+			jobs[result.Key] = JobBatchJobModel{
+				ID:        types.Int64Null(),
+				Status:    types.StringValue(jobBatchSkipped),
+				Output:    types.StringValue(""),
+				Error:     types.StringValue(""),
+				Extravars: items[result.Key],
+			}
+		case result.Job == nil:
+			// the job could not be launched or read, the error is in the diagnostics
+			if !removed {
+				jobs[result.Key] = JobBatchJobModel{
+					ID:        types.Int64Null(),
+					Status:    types.StringValue(restclient.AnsibleStatusFailure),
+					Output:    types.StringValue(""),
+					Error:     types.StringValue(data.maskSecrets(fmt.Sprintf("%v", result.Err))),
+					Extravars: items[result.Key],
+				}
+			}
+		case removed && result.Err == nil:
+			delete(jobs, result.Key)
+		default:
+			extravars := items[result.Key]
+			if removed {
+				extravars = jobs[result.Key].Extravars
+			}
+			jobs[result.Key] = JobBatchJobModel{
+				ID:        types.Int64Value(result.Job.ID),
+				Status:    types.StringValue(result.Job.Status),
+				Output:    types.StringValue(data.maskOutput(result.Job.Output)),
+				Error:     types.StringValue(data.maskSecrets(result.Job.Error)),
+				Extravars: extravars,
+			}
+		}
+	}
+	data.setJobs(ctx, diags, jobs)
+}
+
+// reportJobBatchItemDiags reports the diagnostics of the job of an item, with errors turned into warnings when failuresAsWarnings is set.
+func reportJobBatchItemDiags(ctx context.Context, diags *diag.Diagnostics, itemDiags diag.Diagnostics, failuresAsWarnings bool) {
+	if !failuresAsWarnings {
+		diags.Append(itemDiags...)
+		return
+	}
+	for _, d := range itemDiags {
+		if d.Severity() == diag.SeverityError {
+			tflog.Warn(ctx, fmt.Sprintf("%s: %s", d.Summary(), d.Detail()))
+			diags.AddWarning(d.Summary(), d.Detail())
+			continue
+		}
+		diags.Append(d)
+	}
+}
+
+// Create a new resource.
+// The failures of the jobs do not fail the apply, as the resource would be tainted and every item would run again.
+func (r *JobBatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *JobBatchResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.FormName
+	r.run(ctx, &resp.Diagnostics, data, map[string]JobBatchJobModel{}, true)
+	if data.Jobs.IsUnknown() {
+		// no job was run
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information, the jobs are not read again as the state holds the outcome of the last apply.
+func (r *JobBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *JobBatchResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a job batch resource: %s", data.ID.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update runs the jobs of new, changed, failed and removed items, the failures of the jobs are reported as warnings.
+func (r *JobBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *JobBatchResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobs := state.jobs(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.run(ctx, &resp.Diagnostics, data, jobs, true)
+	if data.Jobs.IsUnknown() {
+		// no job was run
+		return
+	}
+
+	tflog.Trace(ctx, "updated a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete runs the job of each item with state absent, and removes the Terraform state on success.
+func (r *JobBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *JobBatchResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobs := data.jobs(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// with no item left, every job runs with state absent
+	items := data.Items
+	data.Items = types.MapValueMust(types.MapType{ElemType: types.StringType}, map[string]attr.Value{})
+	r.run(ctx, &resp.Diagnostics, data, jobs, false)
+	if resp.Diagnostics.HasError() && !data.Jobs.IsUnknown() {
+		// keep track of the items that could not be removed
+		data.Items = items
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
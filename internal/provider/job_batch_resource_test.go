@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestJobBatchChanges(t *testing.T) {
+	job := func(status string, extravars map[string]string) JobBatchJobModel {
+		return JobBatchJobModel{Status: types.StringValue(status), Extravars: extravars}
+	}
+	small := map[string]string{"size": "10"}
+	large := map[string]string{"size": "20"}
+	tests := []struct {
+		name         string
+		items        map[string]map[string]string
+		jobs         map[string]JobBatchJobModel
+		wantToRun    []string
+		wantToRemove []string
+	}{
+		{
+			name:      "new items sorted by name",
+			items:     map[string]map[string]string{"b": small, "a": small, "c": large},
+			jobs:      map[string]JobBatchJobModel{},
+			wantToRun: []string{"a", "b", "c"},
+		},
+		{
+			name:  "unchanged items",
+			items: map[string]map[string]string{"a": small, "b": large},
+			jobs:  map[string]JobBatchJobModel{"a": job("success", small), "b": job("success", large)},
+		},
+		{
+			name:      "changed extra vars",
+			items:     map[string]map[string]string{"a": large, "b": large},
+			jobs:      map[string]JobBatchJobModel{"a": job("success", small), "b": job("success", large)},
+			wantToRun: []string{"a"},
+		},
+		{
+			name:      "failed, rejected and skipped jobs run again",
+			items:     map[string]map[string]string{"a": small, "b": small, "c": small, "d": small},
+			jobs:      map[string]JobBatchJobModel{"a": job("error", small), "b": job("rejected", small), "c": job(jobBatchSkipped, small), "d": job("success", small)},
+			wantToRun: []string{"a", "b", "c"},
+		},
+		{
+			name:         "removed items",
+			items:        map[string]map[string]string{"a": small},
+			jobs:         map[string]JobBatchJobModel{"a": job("success", small), "c": job("success", small), "b": job("error", large)},
+			wantToRemove: []string{"b", "c"},
+		},
+		{
+			name:  "removed skipped item",
+			items: map[string]map[string]string{},
+			jobs:  map[string]JobBatchJobModel{"a": job(jobBatchSkipped, small)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toRun, toRemove := jobBatchChanges(tt.items, tt.jobs)
+			if !reflect.DeepEqual(toRun, tt.wantToRun) {
+				t.Errorf("jobBatchChanges() to run = %v, want %v", toRun, tt.wantToRun)
+			}
+			if !reflect.DeepEqual(toRemove, tt.wantToRemove) {
+				t.Errorf("jobBatchChanges() to remove = %v, want %v", toRemove, tt.wantToRemove)
+			}
+		})
+	}
+}
+
+func TestJobBatchResourceModel_mask(t *testing.T) {
+	data := JobBatchResourceModel{
+		Credentials: types.MapValueMust(types.StringType, map[string]attr.Value{"vault": types.StringValue("s3cret")}),
+	}
+	output := "<b>TASK [login]</b> fatal: authentication failed with password s3cret"
+	var itemDiags diag.Diagnostics
+	itemDiags.AddError("error running job for item a", "error on job 12: "+output)
+	itemDiags.AddWarning("job pending approval", "password s3cret")
+
+	values := map[string]string{
+		"output": data.maskOutput(output),
+		"error":  data.maskSecrets("error on job 12: " + output),
+	}
+	for _, d := range data.maskDiags(itemDiags) {
+		values[d.Summary()] = d.Detail()
+	}
+	for name, value := range values {
+		if strings.Contains(value, "s3cret") {
+			t.Errorf("%s leaks the secret: %s", name, value)
+		}
+	}
+	if got, want := values["output"], "TASK [login] fatal: authentication failed with password ***"; got != want {
+		t.Errorf("maskOutput() = %q, want %q", got, want)
+	}
+	if masked := data.maskDiags(itemDiags); masked.ErrorsCount() != 1 || masked.WarningsCount() != 1 {
+		t.Errorf("maskDiags() = %v, want one error and one warning", masked)
+	}
+}
+
+func TestAccJobBatchResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobBatchResourceConfig(`{
+    first  = { size = "10" }
+    second = { size = "20" }
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_job_batch.batch", "id", "Demo Form Ansible No input"),
+					resource.TestCheckResourceAttr("ansible-forms_job_batch.batch", "jobs.%", "2"),
+					resource.TestCheckResourceAttr("ansible-forms_job_batch.batch", "jobs.first.status", "success"),
+					resource.TestCheckResourceAttr("ansible-forms_job_batch.batch", "jobs.second.extravars.size", "20"),
+					resource.TestCheckResourceAttrSet("ansible-forms_job_batch.batch", "jobs.first.id")),
+			},
+			{
+				Config: testAccJobBatchResourceConfig(`{
+    first = { size = "15" }
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_job_batch.batch", "jobs.%", "1"),
+					resource.TestCheckResourceAttr("ansible-forms_job_batch.batch", "jobs.first.extravars.size", "15")),
+			},
+		},
+	})
+}
+
+func testAccJobBatchResourceConfig(items string) string {
+	host := os.Getenv("TF_ACC_ANSIBLE_FORMS_HOST")
+	admin := os.Getenv("TF_ACC_ANSIBLE_FORMS_USER")
+	password := os.Getenv("TF_ACC_ANSIBLE_FORMS_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_ANSIBLE_FORMS_HOST, TF_ACC_ANSIBLE_FORMS_USER, and TF_ACC_ANSIBLE_FORMS_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "ansible-forms" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "ansible-forms_job_batch" "batch" {
+  cx_profile_name = "cluster4"
+  form_name       = "Demo Form Ansible No input"
+  items           = %s
+  max_parallel    = 2
+}`, host, admin, password, items)
+}
@@ -3,8 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -37,7 +42,7 @@ type JobDataSourceModel struct {
 	FormName      types.String  `tfsdk:"form_name"`
 	Status        types.String  `tfsdk:"status"`
 	Extravars     types.Dynamic `tfsdk:"extravars"`
-	Credentials   types.Dynamic `tfsdk:"credentials"`
+	Credentials   types.Map     `tfsdk:"credentials"`
 	Target        types.String  `tfsdk:"target"`
 	Output        types.String  `tfsdk:"output"`
 	Counter       types.Int64   `tfsdk:"counter"`
@@ -45,6 +50,7 @@ type JobDataSourceModel struct {
 	Start         types.String  `tfsdk:"start"`
 	End           types.String  `tfsdk:"end"`
 	Approval      types.String  `tfsdk:"approval"`
+	ApprovalInfo  types.Object  `tfsdk:"approval_info"`
 }
 
 // Metadata returns the data source type name.
@@ -64,38 +70,44 @@ func (d *JobDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				Required:            true,
 			},
 			"id": schema.Int64Attribute{
-				MarkdownDescription: "",
-				Required:            true,
+				MarkdownDescription: "ID of a job. Exactly one of id or form_name is required.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("form_name")),
+				},
 			},
 			"last_updated": schema.StringAttribute{
-				MarkdownDescription: "",
+				MarkdownDescription: "Time the job was read.",
 				Computed:            true,
 			},
 			"form_name": schema.StringAttribute{
-				Description: "Form Name.",
-				Computed:    true,
+				MarkdownDescription: "Form name of a job. When id is not set, the latest job of this form is read.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"status": schema.StringAttribute{
-				MarkdownDescription: "",
+				MarkdownDescription: "Status of a job.",
 				Computed:            true,
 			},
 			"extravars": schema.DynamicAttribute{
-				MarkdownDescription: "Extra Vars.",
+				MarkdownDescription: "Extra vars of a job, with their original types. They include the sensitive and write-only extra vars of an `ansible-forms_job_resource`.",
 				Computed:            true,
+				Sensitive:           true,
 			},
-			"credentials": schema.SingleNestedAttribute{
-				MarkdownDescription: "",
-				Attributes: map[string]schema.Attribute{
-					"ontap_cred": schema.StringAttribute{
-						MarkdownDescription: "",
-						Computed:            true,
-					},
-					"cifs_cred": schema.StringAttribute{
-						MarkdownDescription: "cifs_cred",
-						Computed:            true,
-					},
-				},
-				Computed: true,
+			"credentials": schema.MapAttribute{
+				MarkdownDescription: "Credentials of a job, mapping each credential variable to a credential name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"counter": schema.Int64Attribute{
+				MarkdownDescription: "Counter of the job output.",
+				Computed:            true,
+			},
+			"no_of_records": schema.Int64Attribute{
+				MarkdownDescription: "Number of records of the job output.",
+				Computed:            true,
 			},
 			"target": schema.StringAttribute{
 				Computed: true,
@@ -116,7 +128,39 @@ func (d *JobDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 			},
 			"approval": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Approval of a job.",
+				MarkdownDescription: "Approval of a job, formatted as a Go map. Deprecated, use `approval_info` instead.",
+				DeprecationMessage:  "Use approval_info instead, approval is formatted as a Go map and will be removed in a future release.",
+			},
+			"approval_info": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Approval of a job, null when the form does not require approval.",
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Title of the approval request.",
+					},
+					"message": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Message of the approval request.",
+					},
+					"roles": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Roles allowed to approve the job.",
+					},
+					"approved_by": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "User who approved or rejected the job.",
+					},
+					"approved_at": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Time of the approval or rejection.",
+					},
+					"decision": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Approval decision, one of pending, approved or rejected.",
+					},
+				},
 			},
 		},
 	}
@@ -157,21 +201,46 @@ func (d *JobDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	restInfo, err := interfaces.GetJobByID(errorHandler, *client, data.ID.ValueInt64())
+	id := data.ID.ValueInt64()
+	if data.ID.IsNull() {
+		jobs, err := interfaces.FilterJobs(errorHandler, *client, interfaces.JobFilterModel{Form: data.FormName.ValueString(), Limit: 1})
+		if err != nil {
+			// error reporting done inside FilterJobs
+			return
+		}
+		if len(jobs) == 0 {
+			errorHandler.MakeAndReportError("no job found", fmt.Sprintf("no job found for form %s", data.FormName.ValueString()))
+			return
+		}
+		id = jobs[0].ID
+	}
+
+	restInfo, err := interfaces.GetJobByID(errorHandler, *client, id)
 	if err != nil {
-		// error reporting done inside GetSVMPeer
+		// error reporting done inside GetJobByID
 		return
 	}
 
 	if restInfo != nil {
 		data.ID = types.Int64Value(restInfo.ID)
+		data.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC3339))
 		data.FormName = types.StringValue(restInfo.Form)
 		data.Status = types.StringValue(restInfo.Status)
 		data.Target = types.StringValue(restInfo.Target)
 		data.Output = types.StringValue(restInfo.Output)
+		data.Counter = types.Int64Value(restInfo.Counter)
+		data.NoOfRecords = types.Int64Value(restInfo.NoOfRecords)
 		data.Start = types.StringValue(restInfo.Start)
 		data.End = types.StringValue(restInfo.End)
 		data.Approval = types.StringValue(fmt.Sprintf("%s", restInfo.Approval))
+		data.ApprovalInfo = flattenJobApproval(ctx, &resp.Diagnostics, restInfo)
+		data.Credentials = toStringMapValue(ctx, &resp.Diagnostics, restInfo.Credentials)
+		extravars, err := toDynamicValue(restInfo.Extravars)
+		if err != nil {
+			errorHandler.MakeAndReportError("error converting job extravars", err.Error())
+			return
+		}
+		data.Extravars = extravars
 	}
 
 	// Write logs using the tflog package
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// concurrencyKeyExtravar is the extra var carrying the concurrency key of a job, so that running jobs can be found by key.
+const concurrencyKeyExtravar = "terraform_concurrency_key"
+
+// jobLocks serializes the jobs sharing a concurrency key within the provider process.
+var jobLocks = struct {
+	sync.Mutex
+	keys map[string]*sync.Mutex
+}{keys: map[string]*sync.Mutex{}}
+
+// lockConcurrencyKey waits for the jobs holding key to release it, and returns the function releasing it.
+func lockConcurrencyKey(ctx context.Context, key string) func() {
+	jobLocks.Lock()
+	lock, ok := jobLocks.keys[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		jobLocks.keys[key] = lock
+	}
+	jobLocks.Unlock()
+
+	start := time.Now()
+	lock.Lock()
+	if waited := time.Since(start); waited >= time.Second {
+		tflog.Info(ctx, fmt.Sprintf("waited %s for concurrency key %s", waited.Round(time.Second), key))
+	}
+
+	return lock.Unlock
+}
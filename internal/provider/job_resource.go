@@ -2,30 +2,39 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"html"
+	"strings"
 	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/restclient"
 	"terraform-provider-ansible-forms/internal/utils"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/microcosm-cc/bluemonday"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &JobResource{}
-	_ resource.ResourceWithConfigure = &JobResource{}
+	_ resource.Resource                   = &JobResource{}
+	_ resource.ResourceWithConfigure      = &JobResource{}
+	_ resource.ResourceWithModifyPlan     = &JobResource{}
+	_ resource.ResourceWithValidateConfig = &JobResource{}
 )
 
 // NewJobResource is a helper function to simplify the provider implementation.
@@ -44,21 +53,327 @@ type JobResource struct {
 
 // JobResourceModel maps the resource schema data.
 type JobResourceModel struct {
-	CxProfileName types.String `tfsdk:"cx_profile_name"`
-	ID            types.Int64  `tfsdk:"id"`
-	LastUpdated   types.String `tfsdk:"last_updated"`
-	FormName      types.String `tfsdk:"form_name"`
-	Status        types.String `tfsdk:"status"`
-	Extravars     types.Map    `tfsdk:"extravars"`
-	Credentials   types.Map    `tfsdk:"credentials"`
-	Target        types.String `tfsdk:"target"`
-	Output        types.String `tfsdk:"output"`
-	Start         types.String `tfsdk:"start"`
-	End           types.String `tfsdk:"end"`
-	Approval      types.String `tfsdk:"approval"`
-	State         types.String `tfsdk:"state"`
-	Message       types.String `tfsdk:"message"`
-	Error         types.String `tfsdk:"error"`
+	CxProfileName      types.String `tfsdk:"cx_profile_name"`
+	ID                 types.Int64  `tfsdk:"id"`
+	LastUpdated        types.String `tfsdk:"last_updated"`
+	FormName           types.String `tfsdk:"form_name"`
+	Status             types.String `tfsdk:"status"`
+	Extravars          types.Map    `tfsdk:"extravars"`
+	Credentials        types.Map    `tfsdk:"credentials"`
+	SensitiveExtravars types.Map    `tfsdk:"sensitive_extravars"`
+	ExtravarsWO        types.Map    `tfsdk:"extravars_wo"`
+	CredentialsWO      types.Map    `tfsdk:"credentials_wo"`
+	WriteOnlyVersion   types.Int64  `tfsdk:"wo_version"`
+	Target             types.String `tfsdk:"target"`
+	Output             types.String `tfsdk:"output"`
+	Start              types.String `tfsdk:"start"`
+	End                types.String `tfsdk:"end"`
+	Approval           types.String `tfsdk:"approval"`
+	ApprovalInfo       types.Object `tfsdk:"approval_info"`
+	State              types.String `tfsdk:"state"`
+	Message            types.String `tfsdk:"message"`
+	Error              types.String `tfsdk:"error"`
+
+	ApprovalTimeout       types.Int64  `tfsdk:"approval_timeout"`
+	FailOnPendingApproval types.Bool   `tfsdk:"fail_on_pending_approval"`
+	FailOnError           types.Bool   `tfsdk:"fail_on_error"`
+	WaitForCompletion     types.Bool   `tfsdk:"wait_for_completion"`
+	ConcurrencyKey        types.String `tfsdk:"concurrency_key"`
+	CheckRunningJobs      types.Bool   `tfsdk:"concurrency_check_running_jobs"`
+	AdoptExistingJob      types.Bool   `tfsdk:"adopt_existing_job"`
+	Fingerprint           types.String `tfsdk:"fingerprint"`
+	RelaunchTriggers      types.Map    `tfsdk:"relaunch_triggers"`
+}
+
+// waitOptions tells how to wait for a job launched for data, up to the job completion timeout of the provider.
+func (r *JobResource) waitOptions(data *JobResourceModel) restclient.JobWaitOptions {
+	return restclient.JobWaitOptions{
+		ApprovalTimeout:       time.Duration(data.ApprovalTimeout.ValueInt64()) * time.Second,
+		FailOnPendingApproval: data.FailOnPendingApproval.ValueBool(),
+		Timeout:               time.Duration(r.config.providerConfig.JobCompletionTimeOut) * time.Second,
+	}
+}
+
+// submitJob launches a job for this resource, and waits for its completion unless wait_for_completion is false.
+func (m *JobResourceModel) submitJob(errorHandler *utils.ErrorHandler, client restclient.RestClient, request interfaces.JobResourceModel, options restclient.JobWaitOptions) (*interfaces.GetJobResponse, error) {
+	if !m.WaitForCompletion.ValueBool() {
+		return interfaces.StartJob(errorHandler, client, request)
+	}
+
+	return interfaces.SubmitJob(errorHandler, client, request, options)
+}
+
+// relaunchJob relaunches a job for this resource, and waits for its completion unless wait_for_completion is false.
+func (m *JobResourceModel) relaunchJob(errorHandler *utils.ErrorHandler, client restclient.RestClient, id int64, options restclient.JobWaitOptions) (*interfaces.GetJobResponse, error) {
+	if !m.WaitForCompletion.ValueBool() {
+		return interfaces.StartRelaunchJob(errorHandler, client, id)
+	}
+
+	return interfaces.SubmitRelaunchJob(errorHandler, client, id, options)
+}
+
+// fingerprintExtravar is the extra var carrying the fingerprint of a job, so that a job can be found by its inputs.
+const fingerprintExtravar = "terraform_fingerprint"
+
+// adoptJobsLimit is the number of most recent jobs of the form searched for a job to adopt.
+const adoptJobsLimit = 50
+
+// adoptJobsWindow is how long before a create the jobs searched for a job to adopt may have started.
+const adoptJobsWindow = 24 * time.Hour
+
+// launchAttemptPrivateKey is the private state key recording the last launch attempt of the resource.
+const launchAttemptPrivateKey = "launch_attempt"
+
+// jobLaunchAttempt records a launch of the job, a job started since then with the same fingerprint may be adopted when the launch is retried.
+type jobLaunchAttempt struct {
+	Fingerprint string    `json:"fingerprint"`
+	Time        time.Time `json:"time"`
+}
+
+// newJobLaunchAttempt returns the private state value recording a launch of the job of m now.
+func (m *JobResourceModel) newJobLaunchAttempt() []byte {
+	attempt, _ := json.Marshal(jobLaunchAttempt{Fingerprint: m.Fingerprint.ValueString(), Time: time.Now().UTC()})
+	return attempt
+}
+
+// getJobLaunchAttempt decodes the launch attempt recorded in the private state, nil when there is none.
+func getJobLaunchAttempt(value []byte) *jobLaunchAttempt {
+	var attempt jobLaunchAttempt
+	if len(value) == 0 || json.Unmarshal(value, &attempt) != nil {
+		return nil
+	}
+	return &attempt
+}
+
+// fingerprint identifies the inputs of the job: form name, state, extra vars and credentials, including the secrets.
+func (m *JobResourceModel) fingerprint() string {
+	stringMap := func(value types.Map) map[string]string {
+		values := map[string]string{}
+		for k, v := range value.Elements() {
+			if s, ok := v.(types.String); ok {
+				values[k] = s.ValueString()
+			}
+		}
+		return values
+	}
+	// map keys are sorted by json.Marshal, so the fingerprint does not depend on their order
+	input, _ := json.Marshal(map[string]any{
+		"form_name":   m.FormName.ValueString(),
+		"state":       m.State.ValueString(),
+		"extravars":   stringMap(m.Extravars),
+		"credentials": stringMap(m.Credentials),
+		// the secrets are hashed with the other inputs, they cannot be read back from the fingerprint
+		"sensitive_extravars": stringMap(m.SensitiveExtravars),
+		"extravars_wo":        stringMap(m.ExtravarsWO),
+		"credentials_wo":      stringMap(m.CredentialsWO),
+	})
+	sum := sha256.Sum256(input)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// adoptJob returns the most recent job with the fingerprint of data when it is successful or running, or nil.
+// On update, only the jobs started since the previous launch attempt of the resource with the same fingerprint are adopted,
+// so that a job is never adopted when no launch of the same inputs was attempted by the resource.
+// On create, previous is nil, as nothing is recorded when a create fails without a job or Terraform stops during the apply,
+// and the jobs started within adoptJobsWindow are searched. A job is not adopted when the most recent job with the fingerprint
+// ran with another state, for instance when the resource was destroyed since.
+// A running job is waited for unless wait_for_completion is false.
+func (r *JobResource) adoptJob(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *JobResourceModel, previous *jobLaunchAttempt) (*interfaces.GetJobResponse, error) {
+	if !data.AdoptExistingJob.ValueBool() {
+		return nil, nil
+	}
+	since := time.Now().Add(-adoptJobsWindow)
+	if previous != nil {
+		if previous.Fingerprint != data.Fingerprint.ValueString() {
+			return nil, nil
+		}
+		since = previous.Time
+	}
+	found, err := interfaces.FindRecentJob(errorHandler, client, data.FormName.ValueString(), fingerprintExtravar, data.Fingerprint.ValueString(), since, adoptJobsLimit)
+	if err != nil || found == nil {
+		return nil, err
+	}
+	if fmt.Sprintf("%v", found.Extravars["state"]) != data.State.ValueString() ||
+		(found.Status != restclient.AnsibleStatusSuccess && found.Status != interfaces.JobListStatusRunning) {
+		tflog.Debug(errorHandler.Ctx, fmt.Sprintf("job %d with the same fingerprint is not adopted", found.ID), map[string]interface{}{"status": found.Status})
+		return nil, nil
+	}
+	message := fmt.Sprintf("adopted job %d with the same fingerprint instead of launching a new job", found.ID)
+	tflog.Info(errorHandler.Ctx, message, map[string]interface{}{"status": found.Status})
+
+	var job *interfaces.JobGetDataSourceModel
+	if found.Status == interfaces.JobListStatusRunning && data.WaitForCompletion.ValueBool() {
+		job, err = interfaces.AwaitJob(errorHandler, client, found.ID, r.waitOptions(data))
+	} else {
+		job, err = interfaces.GetJobByID(errorHandler, client, found.ID)
+	}
+	if job == nil {
+		return nil, err
+	}
+
+	return &interfaces.GetJobResponse{Status: job.Status, Message: message, Data: *job}, err
+}
+
+// acquireConcurrencyKey waits for the jobs sharing the concurrency key of data, and returns the function releasing the key.
+// Running jobs with the same key, launched by other Terraform processes, are waited for when concurrency_check_running_jobs is set.
+func (r *JobResource) acquireConcurrencyKey(errorHandler *utils.ErrorHandler, client restclient.RestClient, data *JobResourceModel) (func(), error) {
+	if data.ConcurrencyKey.IsNull() {
+		return func() {}, nil
+	}
+	key := data.ConcurrencyKey.ValueString()
+	unlock := lockConcurrencyKey(errorHandler.Ctx, key)
+	if data.CheckRunningJobs.ValueBool() {
+		timeout := time.Duration(r.config.providerConfig.JobCompletionTimeOut) * time.Second
+		if err := interfaces.WaitForRunningJobs(errorHandler, client, concurrencyKeyExtravar, key, timeout); err != nil {
+			unlock()
+			return nil, err
+		}
+	}
+
+	return unlock, nil
+}
+
+// warnPendingApproval warns that a job pending approval no longer holds its concurrency_key, as the key is released when the apply of
+// the resource ends.
+func (m *JobResourceModel) warnPendingApproval(diags *diag.Diagnostics) {
+	if m.ConcurrencyKey.IsNull() || m.Status.ValueString() != restclient.AnsibleJobStatusApprove {
+		return
+	}
+	diags.AddWarning("job pending approval",
+		fmt.Sprintf("job %d is pending approval, other jobs with concurrency_key %s may run before it completes. Set approval_timeout to wait for the approval.",
+			m.ID.ValueInt64(), m.ConcurrencyKey.ValueString()))
+}
+
+// writeOnlyPrivateKey is the private state key recording that the job received write-only values.
+const writeOnlyPrivateKey = "write_only"
+
+// readWriteOnly reads the write-only attributes, which are only available in the configuration.
+func (m *JobResourceModel) readWriteOnly(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	diags.Append(config.GetAttribute(ctx, path.Root("extravars_wo"), &m.ExtravarsWO)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("credentials_wo"), &m.CredentialsWO)...)
+}
+
+// nullWriteOnly removes the write-only values from the model before it is saved, Terraform does not do it when an error is reported.
+func (m *JobResourceModel) nullWriteOnly() {
+	m.ExtravarsWO = types.MapNull(types.StringType)
+	m.CredentialsWO = types.MapNull(types.StringType)
+}
+
+// writeOnlyUsed returns the private state value recording whether the job received write-only values, nil when it did not.
+func (m *JobResourceModel) writeOnlyUsed() []byte {
+	if len(m.ExtravarsWO.Elements()) == 0 && len(m.CredentialsWO.Elements()) == 0 {
+		return nil
+	}
+	return []byte("true")
+}
+
+// addSecrets adds the sensitive extra vars and the write-only extra vars and credentials to a job request.
+func (m *JobResourceModel) addSecrets(extravars map[string]interface{}, credentials map[string]interface{}) {
+	for k, v := range m.SensitiveExtravars.Elements() {
+		extravars[k] = v
+	}
+	for k, v := range m.ExtravarsWO.Elements() {
+		extravars[k] = v
+	}
+	for k, v := range m.CredentialsWO.Elements() {
+		credentials[k] = v
+	}
+}
+
+// secrets returns the values of the sensitive extra vars and of the credentials, they are masked in the logs and in the output of the job.
+func (m *JobResourceModel) secrets() []string {
+	var secrets []string
+	for _, values := range []types.Map{m.SensitiveExtravars, m.Credentials, m.ExtravarsWO, m.CredentialsWO} {
+		for _, value := range values.Elements() {
+			if s, ok := value.(types.String); ok && s.ValueString() != "" {
+				secrets = append(secrets, s.ValueString())
+			}
+		}
+	}
+	return secrets
+}
+
+// maskOutput sanitizes the output or error of the job and masks the secrets in it.
+func (m *JobResourceModel) maskOutput(output string) string {
+	output = html.UnescapeString(bluemonday.StrictPolicy().Sanitize(output))
+	for _, secret := range m.secrets() {
+		output = strings.ReplaceAll(output, secret, "***")
+	}
+	return output
+}
+
+// setJob sets the results of the job launched for this resource.
+func (m *JobResourceModel) setJob(ctx context.Context, diags *diag.Diagnostics, job *interfaces.GetJobResponse) {
+	m.ID = types.Int64Value(job.Data.ID)
+	m.Start = types.StringValue(job.Data.Start)
+	m.End = types.StringValue(job.Data.End)
+	m.Status = types.StringValue(job.Data.Status)
+	m.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	m.Target = types.StringValue(job.Data.Target)
+	m.Output = types.StringValue(m.maskOutput(job.Data.Output))
+	m.Approval = types.StringValue(fmt.Sprintf("%s", job.Data.Approval))
+	m.ApprovalInfo = flattenJobApproval(ctx, diags, &job.Data)
+	m.Message = types.StringValue(job.Message)
+	// the error of a failed job may include its output
+	m.Error = types.StringValue(m.maskOutput(job.Data.Error))
+}
+
+// runsJob tells whether applying the plan over state runs the job again, with a new form run or a relaunch.
+// Changing the other attributes, such as fail_on_error or wait_for_completion, only updates the state.
+func (m *JobResourceModel) runsJob(state *JobResourceModel) bool {
+	return !m.CxProfileName.Equal(state.CxProfileName) ||
+		!m.FormName.Equal(state.FormName) ||
+		!m.State.Equal(state.State) ||
+		!m.Extravars.Equal(state.Extravars) ||
+		!m.SensitiveExtravars.Equal(state.SensitiveExtravars) ||
+		!m.Credentials.Equal(state.Credentials) ||
+		!m.WriteOnlyVersion.Equal(state.WriteOnlyVersion) ||
+		!m.RelaunchTriggers.Equal(state.RelaunchTriggers)
+}
+
+// unknownResults marks the results of the job unknown, as the job runs again.
+func (m *JobResourceModel) unknownResults() {
+	m.ID = types.Int64Unknown()
+	m.LastUpdated = types.StringUnknown()
+	m.Status = types.StringUnknown()
+	m.Target = types.StringUnknown()
+	m.Output = types.StringUnknown()
+	m.Start = types.StringUnknown()
+	m.End = types.StringUnknown()
+	m.Approval = types.StringUnknown()
+	m.ApprovalInfo = types.ObjectUnknown(jobApprovalAttrTypes)
+	m.Message = types.StringUnknown()
+	m.Error = types.StringUnknown()
+	m.Fingerprint = types.StringUnknown()
+}
+
+// keepResults keeps the results of the job from state, as the job does not run again.
+func (m *JobResourceModel) keepResults(state *JobResourceModel) {
+	m.ID = state.ID
+	m.LastUpdated = state.LastUpdated
+	m.Status = state.Status
+	m.Target = state.Target
+	m.Output = state.Output
+	m.Start = state.Start
+	m.End = state.End
+	m.Approval = state.Approval
+	m.ApprovalInfo = state.ApprovalInfo
+	m.Message = state.Message
+	m.Error = state.Error
+	m.Fingerprint = state.Fingerprint
+}
+
+// onlyRelaunchTriggersChanged tells whether the existing job can be relaunched instead of submitting a new form run.
+func (m *JobResourceModel) onlyRelaunchTriggersChanged(state *JobResourceModel) bool {
+	return !state.ID.IsNull() &&
+		!m.RelaunchTriggers.Equal(state.RelaunchTriggers) &&
+		m.FormName.Equal(state.FormName) &&
+		m.Extravars.Equal(state.Extravars) &&
+		m.Credentials.Equal(state.Credentials) &&
+		m.SensitiveExtravars.Equal(state.SensitiveExtravars) &&
+		m.WriteOnlyVersion.Equal(state.WriteOnlyVersion) &&
+		m.State.Equal(state.State)
 }
 
 // Metadata returns the resource type name.
@@ -92,57 +407,141 @@ func (r *JobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			},
 			"credentials": schema.MapAttribute{
 				Optional:            true,
+				Sensitive:           true,
 				ElementType:         types.StringType,
 				MarkdownDescription: "Credentials of a job.",
 			},
+			"sensitive_extravars": schema.MapAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Sensitive extra vars of a job, merged into `extravars`. They are hidden in the plan output and masked in the logs and in `output`, but stored in the state.",
+			},
+			"extravars_wo": schema.MapAttribute{
+				Optional:            true,
+				WriteOnly:           true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Write-only extra vars of a job, merged into `extravars`. They are never stored in the plan or the state, and are masked in the logs and in `output`. Requires Terraform 1.11 or later. Change `wo_version` to run the job again with new values. They are not sent to the job run with state absent on destroy.",
+			},
+			"credentials_wo": schema.MapAttribute{
+				Optional:            true,
+				WriteOnly:           true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Write-only credentials of a job, merged into `credentials`. They are never stored in the plan or the state, and are masked in the logs and in `output`. Requires Terraform 1.11 or later. Change `wo_version` to run the job again with new values. They are not sent to the job run with state absent on destroy.",
+			},
+			"wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of the write-only values, changing it runs the job again with the current `extravars_wo` and `credentials_wo`.",
+			},
+			"relaunch_triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary map of values that, when changed, relaunch the existing job with its original extra vars and credentials instead of submitting a new form run.",
+			},
 			"last_updated": schema.StringAttribute{
-				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+				Computed:            true,
 				MarkdownDescription: "Last update time of a job.",
 			},
 			"status": schema.StringAttribute{
-				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+				Computed:            true,
 				MarkdownDescription: "Status of a job.",
 			},
 			"target": schema.StringAttribute{
-				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+				Computed:            true,
 				MarkdownDescription: "Target form of a job.",
 			},
 			"output": schema.StringAttribute{
-				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+				Computed:            true,
 				MarkdownDescription: "Output of a job.",
 			},
 			"start": schema.StringAttribute{
-				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+				Computed:            true,
 				MarkdownDescription: "Start time of a job.",
 			},
 			"end": schema.StringAttribute{
-				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+				Computed:            true,
 				MarkdownDescription: "End time of a job.",
 			},
 			"approval": schema.StringAttribute{
-				Computed: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+				Computed:            true,
+				MarkdownDescription: "Approval of a job, formatted as a Go map. Deprecated, use `approval_info` instead.",
+				DeprecationMessage:  "Use approval_info instead, approval is formatted as a Go map and will be removed in a future release.",
+			},
+			"approval_info": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Approval of a job, null when the form does not require approval.",
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Title of the approval request.",
+					},
+					"message": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Message of the approval request.",
+					},
+					"roles": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Roles allowed to approve the job.",
+					},
+					"approved_by": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "User who approved or rejected the job.",
+					},
+					"approved_at": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Time of the approval or rejection.",
+					},
+					"decision": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Approval decision, one of pending, approved or rejected.",
+					},
+				},
+			},
+			"approval_timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Time in seconds to wait for a job pending approval. When not set, the job is left pending approval with status `approve`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
 				},
-				MarkdownDescription: "Approval of a job.",
+			},
+			"fail_on_pending_approval": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to fail as soon as the job is pending approval. Defaults to false.",
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether a failed job fails the apply. The failed job is kept in the state either way: when true, the resource is tainted and the job runs again on the next apply; when false, the apply succeeds with status `error`. Defaults to true.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether to wait for the job to complete, up to the provider `job_completion_timeout` not counting the time spent waiting for approval. When false, the job ID and initial status are stored as soon as the job is submitted, use the `ansible-forms_job_wait` data source to wait for the job later. Defaults to true.",
+			},
+			"adopt_existing_job": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: fmt.Sprintf("Whether to adopt, instead of launching a new job, the most recent job with the same `fingerprint` among the %d most recent jobs of the form, when it is successful or running. On update, the job must have started since the previous attempt that failed or timed out; on create, in the last %d hours, unless the resource was destroyed since. A running job is waited for. Defaults to false.", adoptJobsLimit, int(adoptJobsWindow.Hours())),
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("SHA-256 fingerprint of the form name, state, extra vars and credentials of the job, including the sensitive and write-only values. It is sent to the job in the extra var `%s`.", fingerprintExtravar),
+			},
+			"concurrency_key": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Jobs sharing this key run one at a time within the provider process, for instance the name of the SVM the playbook changes. The key is sent to the job in the extra var `%s`. Requires `wait_for_completion`.", concurrencyKeyExtravar),
+			},
+			"concurrency_check_running_jobs": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to also wait for running jobs with the same `concurrency_key` in the job list of Ansible Forms, for instance launched by another Terraform process, up to the provider `job_completion_timeout`. Defaults to false.",
 			},
 			"state": schema.StringAttribute{
 				Description: "State.",
@@ -155,10 +554,7 @@ func (r *JobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			},
 			"message": schema.StringAttribute{
 				Description: "Message of a job.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-				Computed: true,
+				Computed:    true,
 			},
 			"error": schema.StringAttribute{
 				Description: "Error of a job.",
@@ -184,20 +580,77 @@ func (r *JobResource) Configure(_ context.Context, req resource.ConfigureRequest
 	r.config.providerConfig = config
 }
 
+// reportJobError reports a job that did not complete successfully, unless fail_on_error is false and the job failed.
+func (r *JobResource) reportJobError(ctx context.Context, errorHandler *utils.ErrorHandler, data *JobResourceModel, jobErr *interfaces.JobError) {
+	if !data.failsApply(jobErr) {
+		tflog.Warn(ctx, fmt.Sprintf("job %d failed, ignored as fail_on_error is false: %s", jobErr.ID, data.maskOutput(jobErr.Err.Error())))
+		return
+	}
+	errorHandler.MakeAndReportError("error running job", data.maskOutput(jobErr.Error()))
+}
+
+// failsApply tells whether the job error fails the apply, a failed job is ignored when fail_on_error is false.
+func (m *JobResourceModel) failsApply(jobErr *interfaces.JobError) bool {
+	return !jobErr.IsJobFailure() || m.FailOnError.ValueBool()
+}
+
+// ValidateConfig rejects a concurrency_key for a job that is not waited for, as the key only serializes jobs while they are waited for.
+func (r *JobResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data JobResourceModel
+
+	// only read the validated attributes, others may be unknown
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("concurrency_key"), &data.ConcurrencyKey)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("wait_for_completion"), &data.WaitForCompletion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ConcurrencyKey.IsNull() && !data.WaitForCompletion.IsNull() && !data.WaitForCompletion.IsUnknown() && !data.WaitForCompletion.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("concurrency_key"), "Invalid concurrency_key",
+			"concurrency_key requires wait_for_completion, the key is released as soon as the job is submitted when the job is not waited for.")
+	}
+}
+
+// ModifyPlan marks the results of the job unknown when the plan runs the job again, and keeps them from state otherwise.
+// The results have no plan modifiers, this is the only place planning them on update.
+func (r *JobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state *JobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.runsJob(state) {
+		plan.unknownResults()
+	} else {
+		plan.keepResults(state)
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
 // Create a new resource.
 func (r *JobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *JobResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-
-	var request interfaces.JobResourceModel
-	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
-
 	if resp.Diagnostics.HasError() {
 		tflog.Debug(ctx, "error getting req plan")
 		return
 	}
+	data.readWriteOnly(ctx, req.Config, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, writeOnlyPrivateKey, data.writeOnlyUsed())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = restclient.MaskSecrets(restclient.MaskStrings(ctx, data.secrets()...))
+
+	var request interfaces.JobResourceModel
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
@@ -205,6 +658,12 @@ func (r *JobResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	unlock, err := r.acquireConcurrencyKey(errorHandler, *client, data)
+	if err != nil {
+		return
+	}
+	defer unlock()
+
 	var extravars = make(map[string]interface{})
 	for k, v := range data.Extravars.Elements() {
 		extravars[k] = v
@@ -214,13 +673,19 @@ func (r *JobResource) Create(ctx context.Context, req resource.CreateRequest, re
 	for k, v := range data.Credentials.Elements() {
 		credentials[k] = v
 	}
+	data.addSecrets(extravars, credentials)
 
 	extravars["state"] = data.State.ValueString()
+	data.Fingerprint = types.StringValue(data.fingerprint())
+	extravars[fingerprintExtravar] = data.Fingerprint.ValueString()
+	if !data.ConcurrencyKey.IsNull() {
+		extravars[concurrencyKeyExtravar] = data.ConcurrencyKey.ValueString()
+	}
 
 	request.Extravars = extravars
 	request.Credentials = credentials
 
-	if data.Credentials.IsNull() {
+	if data.Credentials.IsNull() && data.CredentialsWO.IsNull() {
 		request.Credentials = nil
 	}
 
@@ -228,24 +693,29 @@ func (r *JobResource) Create(ctx context.Context, req resource.CreateRequest, re
 	request.Form = data.FormName.ValueString()
 	request.State = data.State.ValueString()
 
-	job, err := interfaces.CreateJob(errorHandler, *client, request)
-	if err != nil {
+	job, err := r.adoptJob(errorHandler, *client, data, nil)
+	if job == nil && err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, launchAttemptPrivateKey, data.newJobLaunchAttempt())...)
+		job, err = data.submitJob(errorHandler, *client, request, r.waitOptions(data))
+	}
+	var jobErr *interfaces.JobError
+	if err != nil && !errors.As(err, &jobErr) {
 		tflog.Debug(ctx, "err creating a resource", map[string]interface{}{"err": err})
 		return
 	}
 
-	data.ID = types.Int64Value(job.Data.ID)
-	data.Start = types.StringValue(job.Data.Start)
-	data.End = types.StringValue(job.Data.End)
-	data.Status = types.StringValue(job.Data.Status)
-	data.LastUpdated = types.StringValue(time.Now().UTC().Format(time.RFC3339))
-	data.Target = types.StringValue(job.Data.Target)
-	data.Output = types.StringValue(html.UnescapeString(bluemonday.StrictPolicy().Sanitize(job.Data.Output)))
-	data.Approval = types.StringValue(fmt.Sprintf("%s", job.Data.Approval))
-	data.Message = types.StringValue(job.Message)
-	data.Error = types.StringValue(job.Data.Error)
+	data.setJob(ctx, &resp.Diagnostics, job)
+	data.nullWriteOnly()
+	data.warnPendingApproval(&resp.Diagnostics)
 
-	tflog.Debug(ctx, "JOB ID", map[string]interface{}{"ID": job.Data.ID, "DATA": data})
+	if jobErr != nil {
+		// keep track of the failed job, Terraform taints the resource when an error is reported
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		r.reportJobError(ctx, errorHandler, data, jobErr)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("JOB ID %d: %#v", job.Data.ID, data))
 
 	tflog.Trace(ctx, "created a resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -260,6 +730,7 @@ func (r *JobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = restclient.MaskSecrets(restclient.MaskStrings(ctx, data.secrets()...))
 
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 
@@ -294,8 +765,11 @@ func (r *JobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 	//data.Extravars = jsonStringToMapValue(ctx, &resp.Diagnostics, restInfo.JobGetDataSourceModel.Extravars)
 	//data.Credentials = jsonStringToMapValue(ctx, &resp.Diagnostics, restInfo.JobGetDataSourceModel.Credentials)
-	if job.Output != "" {
-		data.Output = types.StringValue(html.UnescapeString(bluemonday.StrictPolicy().Sanitize(job.Output)))
+	writeOnly, diags := req.Private.GetKey(ctx, writeOnlyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	// the write-only values are not available to mask them in the output again
+	if job.Output != "" && writeOnly == nil {
+		data.Output = types.StringValue(data.maskOutput(job.Output))
 	}
 	if job.Target != "" {
 		data.Target = types.StringValue(job.Target)
@@ -309,6 +783,7 @@ func (r *JobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	if job.Approval != nil {
 		data.Approval = types.StringValue(fmt.Sprintf("%s", job.Approval))
 	}
+	data.ApprovalInfo = flattenJobApproval(ctx, &resp.Diagnostics, job)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -321,17 +796,31 @@ func (r *JobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *JobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *JobResourceModel
+	var state *JobResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, "error getting req plan")
+		return
+	}
+	data.readWriteOnly(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = restclient.MaskSecrets(restclient.MaskStrings(ctx, data.secrets()...))
 
 	var request interfaces.JobResourceModel
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
-
-	if resp.Diagnostics.HasError() {
-		tflog.Debug(ctx, "error getting req plan")
+	if !data.runsJob(state) {
+		// only the settings of the resource changed, the job does not run again
+		data.keepResults(state)
+		tflog.Trace(ctx, "updated a resource")
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, writeOnlyPrivateKey, data.writeOnlyUsed())...)
 
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
@@ -339,44 +828,79 @@ func (r *JobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
-	var extravars = make(map[string]interface{})
-	for k, v := range data.Extravars.Elements() {
-		extravars[k] = v
-	}
-
-	var credentials = make(map[string]interface{})
-	for k, v := range data.Credentials.Elements() {
-		credentials[k] = v
+	unlock, err := r.acquireConcurrencyKey(errorHandler, *client, data)
+	if err != nil {
+		return
 	}
+	defer unlock()
 
-	extravars["state"] = data.State.ValueString()
+	data.Fingerprint = types.StringValue(data.fingerprint())
+	var job *interfaces.GetJobResponse
+	var jobErr *interfaces.JobError
+	if data.onlyRelaunchTriggersChanged(state) {
+		job, err = data.relaunchJob(errorHandler, *client, state.ID.ValueInt64(), r.waitOptions(data))
+		if err != nil && !errors.As(err, &jobErr) {
+			tflog.Debug(ctx, "err relaunching a resource", map[string]interface{}{"err": err})
+			return
+		}
+	} else {
+		var extravars = make(map[string]interface{})
+		for k, v := range data.Extravars.Elements() {
+			extravars[k] = v
+		}
 
-	request.Extravars = extravars
-	request.Credentials = credentials
+		var credentials = make(map[string]interface{})
+		for k, v := range data.Credentials.Elements() {
+			credentials[k] = v
+		}
+		data.addSecrets(extravars, credentials)
 
-	if data.Credentials.IsNull() {
-		request.Credentials = nil
-	}
+		extravars["state"] = data.State.ValueString()
+		extravars[fingerprintExtravar] = data.Fingerprint.ValueString()
+		if !data.ConcurrencyKey.IsNull() {
+			extravars[concurrencyKeyExtravar] = data.ConcurrencyKey.ValueString()
+		}
 
-	request.Form = data.FormName.ValueString()
-	request.State = data.State.ValueString()
+		request.Extravars = extravars
+		request.Credentials = credentials
 
-	job, err := interfaces.CreateJob(errorHandler, *client, request)
-	if err != nil {
-		tflog.Debug(ctx, "err creating/updating a resource", map[string]interface{}{"err": err})
-		return
-	}
-	data.ID = basetypes.NewInt64Value(job.Data.ID)
+		if data.Credentials.IsNull() && data.CredentialsWO.IsNull() {
+			request.Credentials = nil
+		}
 
-	elements := map[string]attr.Value{}
+		request.Form = data.FormName.ValueString()
+		request.State = data.State.ValueString()
 
-	for key, value := range job.Data.Extravars {
-		elements[key] = types.StringValue(fmt.Sprintf("%s", value))
+		previous, diags := req.Private.GetKey(ctx, launchAttemptPrivateKey)
+		resp.Diagnostics.Append(diags...)
+		job, err = r.adoptJob(errorHandler, *client, data, getJobLaunchAttempt(previous))
+		if job == nil && err == nil {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, launchAttemptPrivateKey, data.newJobLaunchAttempt())...)
+			job, err = data.submitJob(errorHandler, *client, request, r.waitOptions(data))
+		}
+		if err != nil && !errors.As(err, &jobErr) {
+			tflog.Debug(ctx, "err creating/updating a resource", map[string]interface{}{"err": err})
+			return
+		}
+	}
+	data.setJob(ctx, &resp.Diagnostics, job)
+	data.nullWriteOnly()
+	data.warnPendingApproval(&resp.Diagnostics)
+
+	if jobErr != nil {
+		failed := data
+		if data.failsApply(jobErr) {
+			// Update failures do not taint the resource, keep track of the failed job with the previous inputs,
+			// so that the next plan runs the job again
+			failed = state
+			failed.keepResults(data)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &failed)...)
+		r.reportJobError(ctx, errorHandler, data, jobErr)
+		return
 	}
 
-	data.Error = types.StringValue(job.Data.Error)
-
-	tflog.Debug(ctx, "JOB ID", map[string]interface{}{"ID": job.Data.ID, "DATA": data})
+	tflog.Debug(ctx, fmt.Sprintf("JOB ID %d: %#v", job.Data.ID, data))
 
 	tflog.Trace(ctx, "update/create a resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -391,6 +915,7 @@ func (r *JobResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = restclient.MaskSecrets(restclient.MaskStrings(ctx, data.secrets()...))
 
 	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
 	if data.ID.IsNull() {
@@ -402,12 +927,25 @@ func (r *JobResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	writeOnly, diags := req.Private.GetKey(ctx, writeOnlyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if writeOnly != nil {
+		resp.Diagnostics.AddWarning("write-only values are not available on destroy",
+			fmt.Sprintf("the job of form %s runs with state absent without extravars_wo and credentials_wo, as Terraform does not provide write-only values on destroy.", data.FormName.ValueString()))
+	}
+
 	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
 	if err != nil {
 		// error reporting done inside NewClient
 		return
 	}
 
+	unlock, err := r.acquireConcurrencyKey(errorHandler, *client, data)
+	if err != nil {
+		return
+	}
+	defer unlock()
+
 	var extravars = make(map[string]interface{})
 	for k, v := range data.Extravars.Elements() {
 		extravars[k] = v
@@ -417,14 +955,22 @@ func (r *JobResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	for k, v := range data.Credentials.Elements() {
 		credentials[k] = v
 	}
+	data.addSecrets(extravars, credentials)
 
 	extravars["state"] = "absent"
+	if !data.Fingerprint.IsNull() {
+		// a later create with the same inputs does not adopt the jobs run before the destroy
+		extravars[fingerprintExtravar] = data.Fingerprint.ValueString()
+	}
+	if !data.ConcurrencyKey.IsNull() {
+		extravars[concurrencyKeyExtravar] = data.ConcurrencyKey.ValueString()
+	}
 
 	var request interfaces.JobResourceModel
 	request.Extravars = extravars
 	request.Credentials = credentials
 
-	if data.Credentials.IsNull() {
+	if data.Credentials.IsNull() && data.CredentialsWO.IsNull() {
 		request.Credentials = nil
 	}
 
@@ -432,7 +978,11 @@ func (r *JobResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	request.Form = data.FormName.ValueString()
 	request.State = "absent"
 
-	_, err = interfaces.CreateJob(errorHandler, *client, request)
+	if !data.WaitForCompletion.ValueBool() {
+		_, err = interfaces.StartJob(errorHandler, *client, request)
+	} else {
+		_, err = interfaces.CreateJob(errorHandler, *client, request, r.waitOptions(data))
+	}
 	if err != nil {
 		tflog.Debug(ctx, "err delete a resource", map[string]interface{}{"err": err})
 		return
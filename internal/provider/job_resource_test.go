@@ -1,12 +1,28 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
 )
 
 func TestAccJobResource(t *testing.T) {
@@ -88,3 +104,364 @@ resource "ansible-forms_job_resource" "job" {
   }
 }`, host, admin, password, jobFormName)
 }
+
+// testJobResourceModel returns the state of a job resource after a successful run of the job.
+func testJobResourceModel(size string) JobResourceModel {
+	return JobResourceModel{
+		CxProfileName:      types.StringValue("cluster4"),
+		FormName:           types.StringValue("demo"),
+		State:              types.StringValue("present"),
+		Extravars:          types.MapValueMust(types.StringType, map[string]attr.Value{"size": types.StringValue(size)}),
+		Credentials:        types.MapNull(types.StringType),
+		SensitiveExtravars: types.MapNull(types.StringType),
+		ExtravarsWO:        types.MapNull(types.StringType),
+		CredentialsWO:      types.MapNull(types.StringType),
+		RelaunchTriggers:   types.MapNull(types.StringType),
+		FailOnError:        types.BoolValue(true),
+		ID:                 types.Int64Value(10),
+		Status:             types.StringValue("success"),
+		Output:             types.StringValue("ok"),
+		Approval:           types.StringValue("map[]"),
+		ApprovalInfo:       types.ObjectNull(jobApprovalAttrTypes),
+		Fingerprint:        types.StringValue("fingerprint"),
+	}
+}
+
+func TestJobResourceModel_runsJob(t *testing.T) {
+	state := testJobResourceModel("10")
+	tests := []struct {
+		name   string
+		change func(plan *JobResourceModel)
+		want   bool
+	}{
+		{
+			name:   "no change",
+			change: func(plan *JobResourceModel) {},
+			want:   false,
+		},
+		{
+			name:   "setting changed",
+			change: func(plan *JobResourceModel) { plan.FailOnError = types.BoolValue(false) },
+			want:   false,
+		},
+		{
+			name: "extra var changed",
+			change: func(plan *JobResourceModel) {
+				plan.Extravars = types.MapValueMust(types.StringType, map[string]attr.Value{"size": types.StringValue("20")})
+			},
+			want: true,
+		},
+		{
+			name: "extra var unknown",
+			change: func(plan *JobResourceModel) {
+				plan.Extravars = types.MapValueMust(types.StringType, map[string]attr.Value{"size": types.StringUnknown()})
+			},
+			want: true,
+		},
+		{
+			name:   "write-only version changed",
+			change: func(plan *JobResourceModel) { plan.WriteOnlyVersion = types.Int64Value(2) },
+			want:   true,
+		},
+		{
+			name: "relaunch triggers changed",
+			change: func(plan *JobResourceModel) {
+				plan.RelaunchTriggers = types.MapValueMust(types.StringType, map[string]attr.Value{"run": types.StringValue("1")})
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := testJobResourceModel("10")
+			tt.change(&plan)
+			if got := plan.runsJob(&state); got != tt.want {
+				t.Errorf("runsJob() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJobResourceModel_failedUpdate(t *testing.T) {
+	var diags diag.Diagnostics
+	state := testJobResourceModel("10")
+	plan := testJobResourceModel("20")
+	plan.unknownResults()
+	if !plan.ID.IsUnknown() || !plan.Status.IsUnknown() || !plan.Output.IsUnknown() || !plan.ApprovalInfo.IsUnknown() || !plan.Fingerprint.IsUnknown() {
+		t.Fatalf("unknownResults() left known results: %#v", plan)
+	}
+
+	// the job of the update fails, the results are saved with the previous inputs
+	plan.setJob(context.Background(), &diags, &interfaces.GetJobResponse{Data: interfaces.JobGetDataSourceModel{ID: 11, Status: "error", Output: "failed"}})
+	if diags.HasError() {
+		t.Fatalf("setJob() diagnostics: %v", diags)
+	}
+	failed := state
+	failed.keepResults(&plan)
+	if failed.ID.ValueInt64() != 11 || failed.Status.ValueString() != "error" || failed.Output.ValueString() != "failed" {
+		t.Errorf("keepResults() did not keep the failed job: %#v", failed)
+	}
+	if !failed.Extravars.Equal(state.Extravars) {
+		t.Errorf("keepResults() changed the inputs: %v", failed.Extravars)
+	}
+
+	// the next plan runs the job again
+	next := testJobResourceModel("20")
+	if !next.runsJob(&failed) {
+		t.Errorf("runsJob() = false after a failed update, want true")
+	}
+
+	// without change, the results of the job are kept
+	same := testJobResourceModel("10")
+	same.FailOnError = types.BoolValue(false)
+	same.unknownResults()
+	same.keepResults(&failed)
+	if !same.ID.Equal(failed.ID) || !same.Status.Equal(failed.Status) || !same.ApprovalInfo.Equal(failed.ApprovalInfo) {
+		t.Errorf("keepResults() = %#v, want the results of %#v", same, failed)
+	}
+}
+
+func TestJobResource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name              string
+		concurrencyKey    tftypes.Value
+		waitForCompletion tftypes.Value
+		wantErr           bool
+	}{
+		{name: "no key", concurrencyKey: tftypes.NewValue(tftypes.String, nil), waitForCompletion: tftypes.NewValue(tftypes.Bool, false)},
+		{name: "key with default wait", concurrencyKey: tftypes.NewValue(tftypes.String, "svm1"), waitForCompletion: tftypes.NewValue(tftypes.Bool, nil)},
+		{name: "key with wait", concurrencyKey: tftypes.NewValue(tftypes.String, "svm1"), waitForCompletion: tftypes.NewValue(tftypes.Bool, true)},
+		{name: "key with unknown wait", concurrencyKey: tftypes.NewValue(tftypes.String, "svm1"), waitForCompletion: tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue)},
+		{name: "unknown key without wait", concurrencyKey: tftypes.NewValue(tftypes.String, tftypes.UnknownValue), waitForCompletion: tftypes.NewValue(tftypes.Bool, false), wantErr: true},
+		{name: "key without wait", concurrencyKey: tftypes.NewValue(tftypes.String, "svm1"), waitForCompletion: tftypes.NewValue(tftypes.Bool, false), wantErr: true},
+	}
+	ctx := context.Background()
+	r := NewJobResource().(*JobResource)
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attrType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			values["concurrency_key"] = tt.concurrencyKey
+			values["wait_for_completion"] = tt.waitForCompletion
+			req := fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}
+			var resp fwresource.ValidateConfigResponse
+			r.ValidateConfig(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateConfig() diagnostics = %v, want error %v", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJobResource_ModifyPlan(t *testing.T) {
+	tests := []struct {
+		name        string
+		change      func(plan *JobResourceModel)
+		wantUnknown bool
+	}{
+		{name: "no change", change: func(plan *JobResourceModel) {}, wantUnknown: false},
+		{name: "setting changed", change: func(plan *JobResourceModel) { plan.WaitForCompletion = types.BoolValue(false) }, wantUnknown: false},
+		{name: "extra var changed", change: func(plan *JobResourceModel) {
+			plan.Extravars = types.MapValueMust(types.StringType, map[string]attr.Value{"size": types.StringValue("20")})
+		}, wantUnknown: true},
+		{name: "unknown extra var", change: func(plan *JobResourceModel) {
+			plan.Extravars = types.MapValueMust(types.StringType, map[string]attr.Value{"size": types.StringUnknown()})
+		}, wantUnknown: true},
+		{name: "relaunch triggers changed", change: func(plan *JobResourceModel) {
+			plan.RelaunchTriggers = types.MapValueMust(types.StringType, map[string]attr.Value{"run": types.StringValue("2")})
+		}, wantUnknown: true},
+	}
+	ctx := context.Background()
+	r := NewJobResource().(*JobResource)
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stateModel := testJobResourceModel("10")
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := state.Set(ctx, &stateModel); diags.HasError() {
+				t.Fatalf("State.Set() diagnostics: %v", diags)
+			}
+			// the results are unknown in the plan proposed by Terraform, as they are computed
+			planModel := testJobResourceModel("10")
+			planModel.unknownResults()
+			tt.change(&planModel)
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, &planModel); diags.HasError() {
+				t.Fatalf("Plan.Set() diagnostics: %v", diags)
+			}
+
+			resp := fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics: %v", resp.Diagnostics)
+			}
+			var got JobResourceModel
+			if diags := resp.Plan.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("Plan.Get() diagnostics: %v", diags)
+			}
+			for name, value := range map[string]attr.Value{"id": got.ID, "status": got.Status, "output": got.Output, "approval_info": got.ApprovalInfo, "fingerprint": got.Fingerprint} {
+				if value.IsUnknown() != tt.wantUnknown {
+					t.Errorf("ModifyPlan() %s = %v, want unknown %v", name, value, tt.wantUnknown)
+				}
+			}
+			if !tt.wantUnknown && !got.ID.Equal(stateModel.ID) {
+				t.Errorf("ModifyPlan() id = %v, want %v from state", got.ID, stateModel.ID)
+			}
+		})
+	}
+}
+
+func TestJobResource_adoptJob(t *testing.T) {
+	now := time.Now()
+	listJobs := func(jobs ...map[string]any) restclient.MockResponse {
+		data := make([]any, 0, len(jobs))
+		for _, job := range jobs {
+			data = append(data, job)
+		}
+		return restclient.MockResponse{ExpectedMethod: "GET", ExpectedURL: "job", StatusCode: 200,
+			Response: restclient.RestResponse{NumRecords: 1, Records: []map[string]any{{"status": "success", "data": data}}}}
+	}
+	job := func(id int64, status string, state string, start time.Time) map[string]any {
+		return map[string]any{"id": id, "formName": "demo", "status": status, "start": start.UTC().Format("2006-01-02 15:04:05"),
+			"extravars": map[string]any{fingerprintExtravar: "fingerprint", "state": state}}
+	}
+	getJob := func(id int64) restclient.MockResponse {
+		record := map[string]any{"status": "success", "data": map[string]any{"id": id, "formName": "demo", "status": "success", "output": "ok"}}
+		return restclient.MockResponse{ExpectedMethod: "GET", ExpectedURL: fmt.Sprintf("job/%d", id), StatusCode: 200,
+			Response: restclient.RestResponse{NumRecords: 1, Records: []map[string]any{record}}}
+	}
+
+	tests := []struct {
+		name      string
+		adopt     bool
+		previous  *jobLaunchAttempt
+		responses []restclient.MockResponse
+		wantID    int64
+	}{
+		{
+			name:      "disabled",
+			responses: nil,
+		},
+		{
+			name:      "create adopts a successful job",
+			adopt:     true,
+			responses: []restclient.MockResponse{listJobs(job(7, "success", "present", now.Add(-time.Hour))), getJob(7)},
+			wantID:    7,
+		},
+		{
+			name:  "create after a destroy",
+			adopt: true,
+			responses: []restclient.MockResponse{listJobs(
+				job(7, "success", "present", now.Add(-time.Hour)),
+				job(8, "success", "absent", now.Add(-time.Minute)),
+			)},
+		},
+		{
+			name:      "create with a failed job",
+			adopt:     true,
+			responses: []restclient.MockResponse{listJobs(job(7, "error", "present", now.Add(-time.Hour)))},
+		},
+		{
+			name:      "create with a job older than the window",
+			adopt:     true,
+			responses: []restclient.MockResponse{listJobs(job(7, "success", "present", now.Add(-adoptJobsWindow-time.Hour)))},
+		},
+		{
+			name:      "update adopts a job started since the previous attempt",
+			adopt:     true,
+			previous:  &jobLaunchAttempt{Fingerprint: "fingerprint", Time: now.Add(-time.Minute)},
+			responses: []restclient.MockResponse{listJobs(job(7, "success", "present", now.Add(-time.Minute))), getJob(7)},
+			wantID:    7,
+		},
+		{
+			name:      "update with a job started before the previous attempt",
+			adopt:     true,
+			previous:  &jobLaunchAttempt{Fingerprint: "fingerprint", Time: now.Add(-time.Minute)},
+			responses: []restclient.MockResponse{listJobs(job(7, "success", "present", now.Add(-time.Hour)))},
+		},
+		{
+			name:     "update with a previous attempt of other inputs",
+			adopt:    true,
+			previous: &jobLaunchAttempt{Fingerprint: "other", Time: now.Add(-time.Minute)},
+		},
+	}
+	r := NewJobResource().(*JobResource)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := restclient.NewMockedRestClient(tt.responses)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var diags diag.Diagnostics
+			errorHandler := utils.NewErrorHandler(context.Background(), &diags)
+			data := testJobResourceModel("10")
+			data.AdoptExistingJob = types.BoolValue(tt.adopt)
+			data.WaitForCompletion = types.BoolValue(true)
+
+			job, err := r.adoptJob(errorHandler, *client, &data, tt.previous)
+			if err != nil {
+				t.Fatalf("adoptJob() error = %v", err)
+			}
+			var gotID int64
+			if job != nil {
+				gotID = job.Data.ID
+			}
+			if gotID != tt.wantID {
+				t.Errorf("adoptJob() = job %d, want job %d", gotID, tt.wantID)
+			}
+			if left := client.MockResponsesLeft(); left != 0 {
+				t.Errorf("%d responses not requested", left)
+			}
+		})
+	}
+}
+
+func TestJobResource_reportJobError(t *testing.T) {
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	var diags diag.Diagnostics
+	errorHandler := utils.NewErrorHandler(ctx, &diags)
+	data := testJobResourceModel("10")
+	data.SensitiveExtravars = types.MapValueMust(types.StringType, map[string]attr.Value{"password": types.StringValue("s3cret")})
+	output := "TASK [login] fatal: authentication failed with password s3cret"
+	job := &interfaces.GetJobResponse{Status: "error", Data: interfaces.JobGetDataSourceModel{ID: 12, Status: "error", Output: output, Error: "job failed: " + output}}
+	jobErr := &interfaces.JobError{ID: 12, Status: "error", Err: errors.New("job failed: " + output)}
+
+	data.setJob(ctx, &diags, job)
+	NewJobResource().(*JobResource).reportJobError(ctx, errorHandler, &data, jobErr)
+
+	if !diags.HasError() {
+		t.Fatal("reportJobError() reported no error")
+	}
+	values := map[string]string{"output": data.Output.ValueString(), "error": data.Error.ValueString(), "logs": logs.String()}
+	for _, d := range diags {
+		values[d.Summary()] = d.Detail()
+	}
+	for name, value := range values {
+		if strings.Contains(value, "s3cret") {
+			t.Errorf("%s leaks the secret: %s", name, value)
+		}
+	}
+	if !strings.Contains(data.Error.ValueString(), "authentication failed with password ***") {
+		t.Errorf("error = %q, want the masked output", data.Error.ValueString())
+	}
+}
+
+func TestJobResource_waitOptions(t *testing.T) {
+	r := NewJobResource().(*JobResource)
+	r.config.providerConfig = Config{JobCompletionTimeOut: 600}
+	data := testJobResourceModel("10")
+	data.ApprovalTimeout = types.Int64Value(3600)
+	data.FailOnPendingApproval = types.BoolValue(true)
+
+	want := restclient.JobWaitOptions{ApprovalTimeout: time.Hour, FailOnPendingApproval: true, Timeout: 10 * time.Minute}
+	if got := r.waitOptions(&data); got != want {
+		t.Errorf("waitOptions() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microcosm-cc/bluemonday"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &JobWaitDataSource{}
+
+// JobWaitDataSource defines the data source implementation.
+type JobWaitDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// NewJobWaitDataSource is a helper function to simplify the provider implementation.
+func NewJobWaitDataSource() datasource.DataSource {
+	return &JobWaitDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "job_wait",
+		},
+	}
+}
+
+// JobWaitDataSourceModel maps the data source schema data.
+type JobWaitDataSourceModel struct {
+	CxProfileName   types.String `tfsdk:"cx_profile_name"`
+	ID              types.Int64  `tfsdk:"id"`
+	Timeout         types.Int64  `tfsdk:"timeout"`
+	ApprovalTimeout types.Int64  `tfsdk:"approval_timeout"`
+	FailOnError     types.Bool   `tfsdk:"fail_on_error"`
+	FormName        types.String `tfsdk:"form_name"`
+	Status          types.String `tfsdk:"status"`
+	Target          types.String `tfsdk:"target"`
+	Output          types.String `tfsdk:"output"`
+	Start           types.String `tfsdk:"start"`
+	End             types.String `tfsdk:"end"`
+	Error           types.String `tfsdk:"error"`
+	ApprovalInfo    types.Object `tfsdk:"approval_info"`
+}
+
+// waitOptions tells how to wait for the job, approval is waited for up to timeout unless approval_timeout is set.
+func (m *JobWaitDataSourceModel) waitOptions() restclient.JobWaitOptions {
+	timeout := restclient.CheckLoopTimeout
+	if !m.Timeout.IsNull() {
+		timeout = time.Duration(m.Timeout.ValueInt64()) * time.Second
+	}
+	approvalTimeout := timeout
+	if !m.ApprovalTimeout.IsNull() {
+		approvalTimeout = time.Duration(m.ApprovalTimeout.ValueInt64()) * time.Second
+	}
+
+	return restclient.JobWaitOptions{
+		ApprovalTimeout: approvalTimeout,
+		Timeout:         timeout,
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *JobWaitDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *JobWaitDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Waits for a job to complete, for instance a job submitted with `wait_for_completion = false`.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the job to wait for.",
+				Required:            true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Time in seconds to wait for the job to complete, not counting the time spent waiting for approval. Defaults to %d.", int64(restclient.CheckLoopTimeout.Seconds())),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"approval_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds to wait for a job pending approval. Defaults to `timeout`, set it to 0 to return as soon as the job is pending approval.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"fail_on_error": schema.BoolAttribute{
+				MarkdownDescription: "Whether a failed job is reported as an error. When false, the data source is read with status `error`. Defaults to true.",
+				Optional:            true,
+			},
+			"form_name": schema.StringAttribute{
+				MarkdownDescription: "Form name of the job.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the job.",
+				Computed:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Target form of the job.",
+				Computed:            true,
+			},
+			"output": schema.StringAttribute{
+				MarkdownDescription: "Output of the job.",
+				Computed:            true,
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "Start time of the job.",
+				Computed:            true,
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "End time of the job.",
+				Computed:            true,
+			},
+			"error": schema.StringAttribute{
+				MarkdownDescription: "Error of the job.",
+				Computed:            true,
+			},
+			"approval_info": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Approval of the job, null when the form does not require approval.",
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Title of the approval request.",
+					},
+					"message": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Message of the approval request.",
+					},
+					"roles": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Roles allowed to approve the job.",
+					},
+					"approved_by": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "User who approved or rejected the job.",
+					},
+					"approved_at": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Time of the approval or rejection.",
+					},
+					"decision": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Approval decision, one of pending, approved or rejected.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *JobWaitDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read waits for the job and refreshes the Terraform state with its result.
+func (d *JobWaitDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JobWaitDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	job, err := interfaces.AwaitJob(errorHandler, *client, data.ID.ValueInt64(), data.waitOptions())
+	var jobErr *interfaces.JobError
+	if err != nil && !errors.As(err, &jobErr) {
+		// error reporting done inside AwaitJob
+		return
+	}
+	if jobErr != nil && (!jobErr.IsJobFailure() || data.FailOnError.IsNull() || data.FailOnError.ValueBool()) {
+		errorHandler.MakeAndReportError("error waiting for job", jobErr.Error())
+		return
+	}
+
+	data.FormName = types.StringValue(job.Form)
+	data.Status = types.StringValue(job.Status)
+	data.Target = types.StringValue(job.Target)
+	data.Output = types.StringValue(html.UnescapeString(bluemonday.StrictPolicy().Sanitize(job.Output)))
+	data.Start = types.StringValue(job.Start)
+	data.End = types.StringValue(job.End)
+	data.Error = types.StringValue(job.Error)
+	data.ApprovalInfo = flattenJobApproval(ctx, &resp.Diagnostics, job)
+
+	tflog.Debug(ctx, fmt.Sprintf("waited for job %d, status %s", data.ID.ValueInt64(), job.Status))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
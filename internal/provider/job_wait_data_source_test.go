@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJobWaitDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobWaitDataSourceConfig("Demo Form Ansible No input"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ansible-forms_job_resource.job", "id"),
+					resource.TestCheckResourceAttrPair("data.ansible-forms_job_wait.job", "id", "ansible-forms_job_resource.job", "id"),
+					resource.TestCheckResourceAttr("data.ansible-forms_job_wait.job", "form_name", "Demo Form Ansible No input"),
+					resource.TestCheckResourceAttr("data.ansible-forms_job_wait.job", "status", "success")),
+			},
+		},
+	})
+}
+
+func testAccJobWaitDataSourceConfig(jobFormName string) string {
+	host := os.Getenv("TF_ACC_ANSIBLE_FORMS_HOST")
+	admin := os.Getenv("TF_ACC_ANSIBLE_FORMS_USER")
+	password := os.Getenv("TF_ACC_ANSIBLE_FORMS_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_ANSIBLE_FORMS_HOST, TF_ACC_ANSIBLE_FORMS_USER, and TF_ACC_ANSIBLE_FORMS_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "ansible-forms" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "ansible-forms_job_resource" "job" {
+  cx_profile_name     = "cluster4"
+  form_name           = "%s"
+  wait_for_completion = false
+}
+
+data "ansible-forms_job_wait" "job" {
+  cx_profile_name = "cluster4"
+  id              = ansible-forms_job_resource.job.id
+  timeout         = 600
+}`, host, admin, password, jobFormName)
+}
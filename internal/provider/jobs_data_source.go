@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &JobsDataSource{}
+
+// JobsDataSource defines the data source implementation.
+type JobsDataSource struct {
+	config resourceOrDataSourceConfig
+}
+
+// NewJobsDataSource is a helper function to simplify the provider implementation.
+func NewJobsDataSource() datasource.DataSource {
+	return &JobsDataSource{
+		config: resourceOrDataSourceConfig{
+			name: "jobs",
+		},
+	}
+}
+
+// JobsDataSourceModel maps the data source schema data.
+type JobsDataSourceModel struct {
+	CxProfileName types.String          `tfsdk:"cx_profile_name"`
+	FormName      types.String          `tfsdk:"form_name"`
+	Status        types.String          `tfsdk:"status"`
+	User          types.String          `tfsdk:"user"`
+	Target        types.String          `tfsdk:"target"`
+	StartAfter    types.String          `tfsdk:"start_after"`
+	StartBefore   types.String          `tfsdk:"start_before"`
+	Limit         types.Int64           `tfsdk:"limit"`
+	Order         types.String          `tfsdk:"order"`
+	Jobs          []JobsDataSourceEntry `tfsdk:"jobs"`
+}
+
+// JobsDataSourceEntry maps a job of the list.
+type JobsDataSourceEntry struct {
+	ID       types.Int64  `tfsdk:"id"`
+	FormName types.String `tfsdk:"form_name"`
+	Status   types.String `tfsdk:"status"`
+	User     types.String `tfsdk:"user"`
+	UserType types.String `tfsdk:"user_type"`
+	JobType  types.String `tfsdk:"job_type"`
+	Target   types.String `tfsdk:"target"`
+	Start    types.String `tfsdk:"start"`
+	End      types.String `tfsdk:"end"`
+}
+
+// Metadata returns the data source type name.
+func (d *JobsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.config.name
+}
+
+// Schema defines the schema for the data source.
+func (d *JobsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists jobs matching the filters, most recent first by default.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				MarkdownDescription: "Connection profile name",
+				Required:            true,
+			},
+			"form_name": schema.StringAttribute{
+				MarkdownDescription: "Only list jobs of this form.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only list jobs with this status, for instance success, failed or running.",
+				Optional:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Only list jobs launched by this user.",
+				Optional:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Only list jobs with this target.",
+				Optional:            true,
+			},
+			"start_after": schema.StringAttribute{
+				MarkdownDescription: "Only list jobs started at or after this RFC3339 time.",
+				Optional:            true,
+			},
+			"start_before": schema.StringAttribute{
+				MarkdownDescription: "Only list jobs started at or before this RFC3339 time.",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of jobs to return.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"order": schema.StringAttribute{
+				MarkdownDescription: "Order of the jobs by ID, desc (most recent first) or asc. Defaults to desc.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"asc", "desc"}...),
+				},
+			},
+			"jobs": schema.ListNestedAttribute{
+				MarkdownDescription: "Jobs matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "ID of a job.",
+							Computed:            true,
+						},
+						"form_name": schema.StringAttribute{
+							MarkdownDescription: "Form name of a job.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of a job.",
+							Computed:            true,
+						},
+						"user": schema.StringAttribute{
+							MarkdownDescription: "User who launched a job.",
+							Computed:            true,
+						},
+						"user_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the user who launched a job.",
+							Computed:            true,
+						},
+						"job_type": schema.StringAttribute{
+							MarkdownDescription: "Type of a job.",
+							Computed:            true,
+						},
+						"target": schema.StringAttribute{
+							MarkdownDescription: "Target of a job.",
+							Computed:            true,
+						},
+						"start": schema.StringAttribute{
+							MarkdownDescription: "Start time of a job.",
+							Computed:            true,
+						},
+						"end": schema.StringAttribute{
+							MarkdownDescription: "End time of a job.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *JobsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	d.config.providerConfig = config
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *JobsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JobsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+
+	filter := interfaces.JobFilterModel{
+		Form:        data.FormName.ValueString(),
+		Status:      data.Status.ValueString(),
+		User:        data.User.ValueString(),
+		Target:      data.Target.ValueString(),
+		Limit:       int(data.Limit.ValueInt64()),
+		OldestFirst: data.Order.ValueString() == "asc",
+	}
+	var err error
+	if !data.StartAfter.IsNull() {
+		if filter.StartAfter, err = time.Parse(time.RFC3339, data.StartAfter.ValueString()); err != nil {
+			errorHandler.MakeAndReportError("invalid start_after", err.Error())
+			return
+		}
+	}
+	if !data.StartBefore.IsNull() {
+		if filter.StartBefore, err = time.Parse(time.RFC3339, data.StartBefore.ValueString()); err != nil {
+			errorHandler.MakeAndReportError("invalid start_before", err.Error())
+			return
+		}
+	}
+
+	// we need to defer setting the client until we can read the connection profile name
+	client, err := getRestClient(errorHandler, d.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	jobs, err := interfaces.FilterJobs(errorHandler, *client, filter)
+	if err != nil {
+		// error reporting done inside FilterJobs
+		return
+	}
+
+	data.Jobs = make([]JobsDataSourceEntry, len(jobs))
+	for index, job := range jobs {
+		data.Jobs[index] = JobsDataSourceEntry{
+			ID:       types.Int64Value(job.ID),
+			FormName: types.StringValue(job.Form),
+			Status:   types.StringValue(job.Status),
+			User:     types.StringValue(job.User),
+			UserType: types.StringValue(job.UserType),
+			JobType:  types.StringValue(job.JobType),
+			Target:   types.StringValue(job.Target),
+			Start:    types.StringValue(job.Start),
+			End:      types.StringValue(job.End),
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a data source: %d jobs", len(data.Jobs)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
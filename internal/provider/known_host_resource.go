@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &KnownHostResource{}
+	_ resource.ResourceWithConfigure   = &KnownHostResource{}
+	_ resource.ResourceWithImportState = &KnownHostResource{}
+)
+
+// NewKnownHostResource is a helper function to simplify the provider implementation.
+func NewKnownHostResource() resource.Resource {
+	return &KnownHostResource{
+		config: resourceOrDataSourceConfig{
+			name: "known_host",
+		},
+	}
+}
+
+// KnownHostResource is the resource implementation.
+type KnownHostResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// KnownHostResourceModel maps the resource schema data.
+type KnownHostResourceModel struct {
+	CxProfileName types.String   `tfsdk:"cx_profile_name"`
+	ID            types.String   `tfsdk:"id"`
+	Host          types.String   `tfsdk:"host"`
+	Key           types.String   `tfsdk:"key"`
+	Entries       []types.String `tfsdk:"entries"`
+}
+
+// Metadata returns the resource type name.
+func (r *KnownHostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *KnownHostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Entry of the known_hosts file Ansible Forms uses to connect to target hosts.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Host of the entry.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Host name or IP address of the target host.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Public host key, for instance `ssh-ed25519 AAAA...`. When not set, Ansible Forms scans the keys of the host.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entries": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Lines of the known_hosts file for the host.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *KnownHostResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// Create a new resource.
+func (r *KnownHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *KnownHostResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.AddKnownHost(errorHandler, *client, data.Host.ValueString(), data.Key.ValueString()); err != nil {
+		return
+	}
+	lines, err := interfaces.ListKnownHosts(errorHandler, *client)
+	if err != nil {
+		return
+	}
+	entries := interfaces.KnownHostEntries(lines, data.Host.ValueString())
+	if len(entries) == 0 {
+		errorHandler.MakeAndReportError("error adding known host", fmt.Sprintf("no known_hosts entry for %s after adding it", data.Host.ValueString()))
+		return
+	}
+	data.ID = data.Host
+	data.Entries = flattenTypesStringList(entries)
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *KnownHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *KnownHostResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	lines, err := interfaces.ListKnownHosts(errorHandler, *client)
+	if err != nil {
+		return
+	}
+	entries := interfaces.KnownHostEntries(lines, data.Host.ValueString())
+	if len(entries) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("known host %s not found, removing it from state", data.Host.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.ID = data.Host
+	data.Entries = flattenTypesStringList(entries)
+
+	tflog.Debug(ctx, fmt.Sprintf("read a known host resource: %s", data.Host.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called, all the attributes require a replacement.
+func (r *KnownHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *KnownHostResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *KnownHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *KnownHostResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.RemoveKnownHost(errorHandler, *client, data.Host.ValueString()); err != nil {
+		return
+	}
+}
+
+// ImportState imports a known host, using an ID of the form <host>,<cx_profile_name>.
+func (r *KnownHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: host,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
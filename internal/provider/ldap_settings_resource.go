@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &LDAPSettingsResource{}
+	_ resource.ResourceWithConfigure   = &LDAPSettingsResource{}
+	_ resource.ResourceWithImportState = &LDAPSettingsResource{}
+)
+
+// NewLDAPSettingsResource is a helper function to simplify the provider implementation.
+func NewLDAPSettingsResource() resource.Resource {
+	return &LDAPSettingsResource{
+		config: resourceOrDataSourceConfig{
+			name: "ldap_settings",
+		},
+	}
+}
+
+// LDAPSettingsResource is the resource implementation.
+type LDAPSettingsResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// LDAPSettingsResourceModel maps the resource schema data.
+type LDAPSettingsResourceModel struct {
+	CxProfileName            types.String `tfsdk:"cx_profile_name"`
+	ID                       types.String `tfsdk:"id"`
+	Enable                   types.Bool   `tfsdk:"enable"`
+	Server                   types.String `tfsdk:"server"`
+	Port                     types.Int64  `tfsdk:"port"`
+	IgnoreCerts              types.Bool   `tfsdk:"ignore_certs"`
+	EnableTLS                types.Bool   `tfsdk:"enable_tls"`
+	Cert                     types.String `tfsdk:"cert"`
+	CABundle                 types.String `tfsdk:"ca_bundle"`
+	BindUserDN               types.String `tfsdk:"bind_user_dn"`
+	BindUserPassword         types.String `tfsdk:"bind_user_password"`
+	SearchBase               types.String `tfsdk:"search_base"`
+	UsernameAttribute        types.String `tfsdk:"username_attribute"`
+	GroupsAttribute          types.String `tfsdk:"groups_attribute"`
+	GroupsSearchBase         types.String `tfsdk:"groups_search_base"`
+	GroupClass               types.String `tfsdk:"group_class"`
+	GroupMemberAttribute     types.String `tfsdk:"group_member_attribute"`
+	GroupMemberUserAttribute types.String `tfsdk:"group_member_user_attribute"`
+	MailAttribute            types.String `tfsdk:"mail_attribute"`
+	TestConnection           types.Bool   `tfsdk:"test_connection"`
+}
+
+// Metadata returns the resource type name.
+func (r *LDAPSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *LDAPSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "LDAP settings of Ansible Forms. There is a single instance per connection profile, deleting it disables LDAP authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Connection profile name of the settings.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether LDAP authentication is enabled. Defaults to true.",
+			},
+			"server": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Host name or IP address of the LDAP server.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(389),
+				MarkdownDescription: "Port of the LDAP server. Defaults to 389.",
+			},
+			"ignore_certs": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to skip the validation of the certificate of the LDAP server.",
+			},
+			"enable_tls": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to connect with TLS.",
+			},
+			"cert": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "PEM encoded client certificate.",
+			},
+			"ca_bundle": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "PEM encoded CA bundle used to validate the certificate of the LDAP server.",
+			},
+			"bind_user_dn": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "DN of the user binding to the LDAP server.",
+			},
+			"bind_user_password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password of the user binding to the LDAP server.",
+			},
+			"search_base": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Base DN to search users.",
+			},
+			"username_attribute": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("sAMAccountName"),
+				MarkdownDescription: "Attribute holding the user name. Defaults to sAMAccountName.",
+			},
+			"groups_attribute": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("memberOf"),
+				MarkdownDescription: "Attribute of a user holding its groups. Defaults to memberOf.",
+			},
+			"groups_search_base": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Base DN to search groups, when groups are looked up instead of read from groups_attribute.",
+			},
+			"group_class": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Object class of groups.",
+			},
+			"group_member_attribute": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Attribute of a group holding its members.",
+			},
+			"group_member_user_attribute": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Attribute of a user referenced by group_member_attribute.",
+			},
+			"mail_attribute": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("mail"),
+				MarkdownDescription: "Attribute holding the email address. Defaults to mail.",
+			},
+			"test_connection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Test the connection to the LDAP server before saving the settings, the apply fails if Ansible Forms cannot bind.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *LDAPSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// settings builds the LDAP settings from the model.
+func (m *LDAPSettingsResourceModel) settings() interfaces.LDAPSettingsDataModel {
+	return interfaces.LDAPSettingsDataModel{
+		Enable:                   m.Enable.ValueBool(),
+		Server:                   m.Server.ValueString(),
+		Port:                     m.Port.ValueInt64(),
+		IgnoreCerts:              m.IgnoreCerts.ValueBool(),
+		EnableTLS:                m.EnableTLS.ValueBool(),
+		Cert:                     m.Cert.ValueString(),
+		CABundle:                 m.CABundle.ValueString(),
+		BindUserDN:               m.BindUserDN.ValueString(),
+		BindUserPassword:         m.BindUserPassword.ValueString(),
+		SearchBase:               m.SearchBase.ValueString(),
+		UsernameAttribute:        m.UsernameAttribute.ValueString(),
+		GroupsAttribute:          m.GroupsAttribute.ValueString(),
+		GroupsSearchBase:         m.GroupsSearchBase.ValueString(),
+		GroupClass:               m.GroupClass.ValueString(),
+		GroupMemberAttribute:     m.GroupMemberAttribute.ValueString(),
+		GroupMemberUserAttribute: m.GroupMemberUserAttribute.ValueString(),
+		MailAttribute:            m.MailAttribute.ValueString(),
+	}
+}
+
+// save tests the connection when requested and saves the settings.
+func (r *LDAPSettingsResource) save(ctx context.Context, errorHandler *utils.ErrorHandler, data *LDAPSettingsResourceModel) error {
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return err
+	}
+	settings := data.settings()
+	if data.TestConnection.ValueBool() {
+		if err = interfaces.CheckLDAPSettings(errorHandler, *client, settings); err != nil {
+			return err
+		}
+	}
+	if err = interfaces.UpdateLDAPSettings(errorHandler, *client, settings); err != nil {
+		return err
+	}
+	data.ID = data.CxProfileName
+	tflog.Debug(ctx, fmt.Sprintf("saved LDAP settings for %s", data.CxProfileName.ValueString()))
+
+	return nil
+}
+
+// Create a new resource.
+func (r *LDAPSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *LDAPSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := r.save(ctx, errorHandler, data); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *LDAPSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *LDAPSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	settings, err := interfaces.GetLDAPSettings(errorHandler, *client)
+	if err != nil {
+		return
+	}
+
+	// the bind password is not returned in clear text, keep the one from the state
+	data.ID = data.CxProfileName
+	data.Enable = types.BoolValue(settings.Enable)
+	data.Server = types.StringValue(settings.Server)
+	data.Port = types.Int64Value(settings.Port)
+	data.IgnoreCerts = types.BoolValue(settings.IgnoreCerts)
+	data.EnableTLS = types.BoolValue(settings.EnableTLS)
+	data.Cert = types.StringValue(settings.Cert)
+	data.CABundle = types.StringValue(settings.CABundle)
+	data.BindUserDN = types.StringValue(settings.BindUserDN)
+	data.SearchBase = types.StringValue(settings.SearchBase)
+	data.UsernameAttribute = types.StringValue(settings.UsernameAttribute)
+	data.GroupsAttribute = types.StringValue(settings.GroupsAttribute)
+	data.GroupsSearchBase = types.StringValue(settings.GroupsSearchBase)
+	data.GroupClass = types.StringValue(settings.GroupClass)
+	data.GroupMemberAttribute = types.StringValue(settings.GroupMemberAttribute)
+	data.GroupMemberUserAttribute = types.StringValue(settings.GroupMemberUserAttribute)
+	data.MailAttribute = types.StringValue(settings.MailAttribute)
+	if data.TestConnection.IsNull() {
+		// after import
+		data.TestConnection = types.BoolValue(false)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read LDAP settings for %s", data.CxProfileName.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *LDAPSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *LDAPSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := r.save(ctx, errorHandler, data); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete disables LDAP authentication, the settings are a singleton that cannot be removed.
+func (r *LDAPSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *LDAPSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	settings := data.settings()
+	settings.Enable = false
+	if err = interfaces.UpdateLDAPSettings(errorHandler, *client, settings); err != nil {
+		return
+	}
+}
+
+// ImportState imports the LDAP settings, using the connection profile name as ID.
+func (r *LDAPSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cx_profile_name"), req, resp)
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLDAPSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSettingsResourceConfig("dc=example,dc=com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_ldap_settings.ldap", "id", "cluster4"),
+					resource.TestCheckResourceAttr("ansible-forms_ldap_settings.ldap", "server", "ldap.example.com"),
+					resource.TestCheckResourceAttr("ansible-forms_ldap_settings.ldap", "port", "389"),
+					resource.TestCheckResourceAttr("ansible-forms_ldap_settings.ldap", "username_attribute", "sAMAccountName"),
+					resource.TestCheckResourceAttr("ansible-forms_ldap_settings.ldap", "search_base", "dc=example,dc=com")),
+			},
+			{
+				Config: testAccLDAPSettingsResourceConfig("ou=users,dc=example,dc=com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_ldap_settings.ldap", "search_base", "ou=users,dc=example,dc=com")),
+			},
+			{
+				ResourceName:            "ansible-forms_ldap_settings.ldap",
+				ImportState:             true,
+				ImportStateId:           "cluster4",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bind_user_password"},
+			},
+		},
+	})
+}
+
+func testAccLDAPSettingsResourceConfig(searchBase string) string {
+	host := os.Getenv("TF_ACC_ANSIBLE_FORMS_HOST")
+	admin := os.Getenv("TF_ACC_ANSIBLE_FORMS_USER")
+	password := os.Getenv("TF_ACC_ANSIBLE_FORMS_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_ANSIBLE_FORMS_HOST, TF_ACC_ANSIBLE_FORMS_USER, and TF_ACC_ANSIBLE_FORMS_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "ansible-forms" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "ansible-forms_ldap_settings" "ldap" {
+  cx_profile_name    = "cluster4"
+  enable             = false
+  server             = "ldap.example.com"
+  bind_user_dn       = "cn=admin,dc=example,dc=com"
+  bind_user_password = "mypassword"
+  search_base        = "%s"
+}`, host, admin, password, searchBase)
+}
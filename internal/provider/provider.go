@@ -42,6 +42,8 @@ type ConnectionProfileModel struct {
 type AnsibleFormsProviderModel struct {
 	Endpoint             types.String             `tfsdk:"endpoint"`
 	JobCompletionTimeOut types.Int64              `tfsdk:"job_completion_timeout"`
+	JobProgressInterval  types.Int64              `tfsdk:"job_progress_interval"`
+	LogBodies            types.Bool               `tfsdk:"log_bodies"`
 	ConnectionProfiles   []ConnectionProfileModel `tfsdk:"connection_profiles"`
 }
 
@@ -63,6 +65,14 @@ func (p *AnsibleFormsProvider) Schema(_ context.Context, _ provider.SchemaReques
 				MarkdownDescription: "Time in seconds to wait for completion. Default to 600 seconds",
 				Optional:            true,
 			},
+			"job_progress_interval": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds between progress summaries (elapsed time, current task) logged while waiting for a job. The job output is logged with the `ansible_forms_job` subsystem, set `TF_LOG_PROVIDER_ANSIBLE_FORMS_JOB=INFO` to see it. Default to 0, no summary",
+				Optional:            true,
+			},
+			"log_bodies": schema.BoolAttribute{
+				MarkdownDescription: "Whether to log the bodies of requests and responses at DEBUG level, and the responses of failed requests at ERROR level. Passwords, tokens, credentials and sensitive extra vars are masked in the logs, but the bodies may contain other confidential data. Default to false",
+				Optional:            true,
+			},
 			"connection_profiles": schema.ListNestedAttribute{
 				MarkdownDescription: "Define connection and credentials",
 				Required:            true,
@@ -134,6 +144,8 @@ func (p *AnsibleFormsProvider) Configure(ctx context.Context, req provider.Confi
 	config := Config{
 		ConnectionProfiles:   connectionProfiles,
 		JobCompletionTimeOut: int(jobCompletionTimeOut),
+		JobProgressInterval:  int(data.JobProgressInterval.ValueInt64()),
+		LogBodies:            data.LogBodies.ValueBool(),
 		Version:              p.version,
 	}
 	resp.DataSourceData = config
@@ -144,6 +156,22 @@ func (p *AnsibleFormsProvider) Configure(ctx context.Context, req provider.Confi
 func (p *AnsibleFormsProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewJobResource,
+		NewJobApprovalResource,
+		NewJobBatchResource,
+		NewCredentialResource,
+		NewFormResource,
+		NewGroupResource,
+		NewUserResource,
+		NewLDAPSettingsResource,
+		NewAzureADSettingsResource,
+		NewOIDCSettingsResource,
+		NewAWXInstanceResource,
+		NewRepositoryResource,
+		NewDatasourceSchemaResource,
+		NewDatasourceResource,
+		NewSSHKeyResource,
+		NewKnownHostResource,
+		NewSettingsResource,
 	}
 }
 
@@ -151,6 +179,14 @@ func (p *AnsibleFormsProvider) Resources(_ context.Context) []func() resource.Re
 func (p *AnsibleFormsProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewJobDataSource,
+		NewJobsDataSource,
+		NewJobWaitDataSource,
+		NewCredentialDataSource,
+		NewFormsDataSource,
+		NewFormDataSource,
+		NewGroupDataSource,
+		NewUserDataSource,
+		NewAWXInstancesDataSource,
 	}
 }
 
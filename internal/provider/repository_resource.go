@@ -0,0 +1,383 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/restclient"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &RepositoryResource{}
+	_ resource.ResourceWithConfigure   = &RepositoryResource{}
+	_ resource.ResourceWithImportState = &RepositoryResource{}
+	_ resource.ResourceWithModifyPlan  = &RepositoryResource{}
+)
+
+// NewRepositoryResource is a helper function to simplify the provider implementation.
+func NewRepositoryResource() resource.Resource {
+	return &RepositoryResource{
+		config: resourceOrDataSourceConfig{
+			name: "repository",
+		},
+	}
+}
+
+// RepositoryResource is the resource implementation.
+type RepositoryResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// RepositoryResourceModel maps the resource schema data.
+type RepositoryResourceModel struct {
+	CxProfileName   types.String `tfsdk:"cx_profile_name"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	URI             types.String `tfsdk:"uri"`
+	Branch          types.String `tfsdk:"branch"`
+	Credential      types.String `tfsdk:"credential"`
+	Description     types.String `tfsdk:"description"`
+	Cron            types.String `tfsdk:"cron"`
+	UseForForms     types.Bool   `tfsdk:"use_for_forms"`
+	UseForPlaybooks types.Bool   `tfsdk:"use_for_playbooks"`
+	PullTriggers    types.Map    `tfsdk:"pull_triggers"`
+	ResetOnPull     types.Bool   `tfsdk:"reset_on_pull"`
+	Commit          types.String `tfsdk:"commit"`
+	PullOutput      types.String `tfsdk:"pull_output"`
+}
+
+// Metadata returns the resource type name.
+func (r *RepositoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *RepositoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Git repository Ansible Forms pulls playbooks or forms from.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the repository, also the name of its local clone.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "URI of the git repository.",
+			},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("main"),
+				MarkdownDescription: "Branch to pull. Defaults to main.",
+			},
+			"credential": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of the Ansible Forms credential used to authenticate to the git server, see `ansible-forms_credential`.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Description of the repository.",
+			},
+			"cron": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Cron expression to pull the repository periodically, for instance `*/15 * * * *`. Empty to disable.",
+			},
+			"use_for_forms": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the forms configuration is read from the repository.",
+			},
+			"use_for_playbooks": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether playbooks are read from the repository.",
+			},
+			"pull_triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary map of values that, when changed, pull the repository, `commit` reports the resulting commit.",
+			},
+			"reset_on_pull": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Reset the local clone to the remote branch instead of merging when pulling, local changes are lost.",
+			},
+			"commit": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Last commit of the local clone after the last create or pull.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pull_output": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Git output of the last pull.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *RepositoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// ModifyPlan plans a pull when pull_triggers, uri or branch changed.
+func (r *RepositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state *RepositoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !plan.pulls(state) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("commit"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pull_output"), types.StringUnknown())...)
+}
+
+// repository builds the repository from the model.
+func (m *RepositoryResourceModel) repository() interfaces.RepositoryDataModel {
+	return interfaces.RepositoryDataModel{
+		Name:            m.Name.ValueString(),
+		URI:             m.URI.ValueString(),
+		Branch:          m.Branch.ValueString(),
+		Credential:      m.Credential.ValueString(),
+		Description:     m.Description.ValueString(),
+		Cron:            m.Cron.ValueString(),
+		UseForForms:     m.UseForForms.ValueBool(),
+		UseForPlaybooks: m.UseForPlaybooks.ValueBool(),
+	}
+}
+
+// pulls tells whether the repository is pulled on update, when pull_triggers changed since state.
+// A new uri or branch is only effective after a pull, so the repository is pulled when they change too.
+func (m *RepositoryResourceModel) pulls(state *RepositoryResourceModel) bool {
+	if !m.URI.Equal(state.URI) || !m.Branch.Equal(state.Branch) {
+		return true
+	}
+	return !m.PullTriggers.IsNull() && !m.PullTriggers.Equal(state.PullTriggers)
+}
+
+// pull pulls the repository when requested and records the resulting commit.
+// Without a pull, a commit already known from the state is kept as the plan does not expect a new one.
+func (m *RepositoryResourceModel) pull(errorHandler *utils.ErrorHandler, client restclient.RestClient, pull bool) error {
+	if pull {
+		output, err := interfaces.PullRepository(errorHandler, client, m.Name.ValueString(), m.ResetOnPull.ValueBool())
+		if err != nil {
+			return err
+		}
+		m.PullOutput = types.StringValue(output)
+	}
+	if m.PullOutput.IsUnknown() {
+		m.PullOutput = types.StringValue("")
+	}
+	if !pull && !m.Commit.IsUnknown() {
+		return nil
+	}
+	commit, err := interfaces.GetRepositoryCommit(errorHandler, client, m.Name.ValueString())
+	if err != nil {
+		return err
+	}
+	m.Commit = types.StringValue(commit)
+
+	return nil
+}
+
+// Create a new resource.
+func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *RepositoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.CreateRepository(errorHandler, *client, data.repository()); err != nil {
+		return
+	}
+	data.ID = data.Name
+	// the repository is cloned on creation, only report the commit
+	if err = data.pull(errorHandler, *client, false); err != nil {
+		// keep the repository in the state so it can be deleted
+		data.Commit = types.StringNull()
+		data.PullOutput = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *RepositoryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	repository, err := interfaces.GetRepository(errorHandler, *client, data.Name.ValueString())
+	if err != nil {
+		return
+	}
+	if repository == nil {
+		tflog.Debug(ctx, fmt.Sprintf("repository %s not found, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(repository.Name)
+	data.URI = types.StringValue(repository.URI)
+	data.Branch = types.StringValue(repository.Branch)
+	data.Credential = stringOrNull(repository.Credential, data.Credential)
+	data.Description = types.StringValue(repository.Description)
+	data.Cron = types.StringValue(repository.Cron)
+	data.UseForForms = types.BoolValue(repository.UseForForms)
+	data.UseForPlaybooks = types.BoolValue(repository.UseForPlaybooks)
+	if data.ResetOnPull.IsNull() {
+		// after import
+		data.ResetOnPull = types.BoolValue(false)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("read a repository resource: %s", repository.Name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *RepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *RepositoryResourceModel
+	var state *RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.UpdateRepository(errorHandler, *client, data.repository()); err != nil {
+		return
+	}
+	if err = data.pull(errorHandler, *client, data.pulls(state)); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *RepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	if err = interfaces.DeleteRepository(errorHandler, *client, data.Name.ValueString()); err != nil {
+		return
+	}
+}
+
+// ImportState imports a repository by name, using an ID of the form <name>,<cx_profile_name>.
+func (r *RepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: name,cx_profile_name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cx_profile_name"), idParts[1])...)
+}
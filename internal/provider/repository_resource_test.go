@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestRepositoryResourceModel_pulls(t *testing.T) {
+	model := func(branch string, triggers types.Map) *RepositoryResourceModel {
+		return &RepositoryResourceModel{
+			URI:          types.StringValue("https://git.example.com/playbooks.git"),
+			Branch:       types.StringValue(branch),
+			PullTriggers: triggers,
+		}
+	}
+	triggers := func(value string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"release": types.StringValue(value)})
+	}
+	noTriggers := types.MapNull(types.StringType)
+	tests := []struct {
+		name  string
+		plan  *RepositoryResourceModel
+		state *RepositoryResourceModel
+		want  bool
+	}{
+		{name: "no change", plan: model("main", triggers("1")), state: model("main", triggers("1")), want: false},
+		{name: "no triggers", plan: model("main", noTriggers), state: model("main", noTriggers), want: false},
+		{name: "changed triggers", plan: model("main", triggers("2")), state: model("main", triggers("1")), want: true},
+		{name: "new triggers", plan: model("main", triggers("1")), state: model("main", noTriggers), want: true},
+		{name: "unknown triggers", plan: model("main", types.MapUnknown(types.StringType)), state: model("main", triggers("1")), want: true},
+		{name: "removed triggers", plan: model("main", noTriggers), state: model("main", triggers("1")), want: false},
+		{name: "changed branch", plan: model("dev", noTriggers), state: model("main", noTriggers), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.plan.pulls(tt.state); got != tt.want {
+				t.Errorf("pulls() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccRepositoryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryResourceConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_repository.repository", "name", "tf_acc_repository"),
+					resource.TestCheckResourceAttr("ansible-forms_repository.repository", "branch", "main"),
+					resource.TestCheckResourceAttr("ansible-forms_repository.repository", "use_for_playbooks", "true"),
+					resource.TestCheckResourceAttrSet("ansible-forms_repository.repository", "commit")),
+			},
+			{
+				Config: testAccRepositoryResourceConfig(`
+  pull_triggers = {
+    run = "1"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ansible-forms_repository.repository", "commit"),
+					resource.TestCheckResourceAttrSet("ansible-forms_repository.repository", "pull_output")),
+			},
+			{
+				ResourceName:            "ansible-forms_repository.repository",
+				ImportState:             true,
+				ImportStateId:           "tf_acc_repository,cluster4",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pull_triggers", "reset_on_pull", "commit", "pull_output"},
+			},
+		},
+	})
+}
+
+func testAccRepositoryResourceConfig(pullTriggers string) string {
+	host := os.Getenv("TF_ACC_ANSIBLE_FORMS_HOST")
+	admin := os.Getenv("TF_ACC_ANSIBLE_FORMS_USER")
+	password := os.Getenv("TF_ACC_ANSIBLE_FORMS_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_ANSIBLE_FORMS_HOST, TF_ACC_ANSIBLE_FORMS_USER, and TF_ACC_ANSIBLE_FORMS_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "ansible-forms" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "ansible-forms_repository" "repository" {
+  cx_profile_name   = "cluster4"
+  name              = "tf_acc_repository"
+  uri               = "https://github.com/ansibleguy76/ansibleforms-demo.git"
+  use_for_playbooks = true%s
+}`, host, admin, password, pullTriggers)
+}
@@ -3,11 +3,16 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
+	"terraform-provider-ansible-forms/internal/interfaces"
 	"terraform-provider-ansible-forms/internal/restclient"
 	"terraform-provider-ansible-forms/internal/utils"
 )
@@ -79,3 +84,173 @@ func jsonStringToMapValue(ctx context.Context, diags *diag.Diagnostics, str stri
 
 	return m
 }
+
+// jobApprovalAttrTypes describes the approval object of a job.
+var jobApprovalAttrTypes = map[string]attr.Type{
+	"title":       types.StringType,
+	"message":     types.StringType,
+	"roles":       types.ListType{ElemType: types.StringType},
+	"approved_by": types.StringType,
+	"approved_at": types.StringType,
+	"decision":    types.StringType,
+}
+
+// flattenJobApproval converts the approval of a job to an object, null if the job does not require approval.
+func flattenJobApproval(ctx context.Context, diags *diag.Diagnostics, job *interfaces.JobGetDataSourceModel) basetypes.ObjectValue {
+	approval, err := job.GetApproval()
+	if err != nil {
+		diags.AddError("error decoding job approval", err.Error())
+		return types.ObjectNull(jobApprovalAttrTypes)
+	}
+	if approval == nil {
+		return types.ObjectNull(jobApprovalAttrTypes)
+	}
+
+	roles, d := types.ListValueFrom(ctx, types.StringType, approval.Roles)
+	diags.Append(d...)
+	o, d := types.ObjectValue(jobApprovalAttrTypes, map[string]attr.Value{
+		"title":       types.StringValue(approval.Title),
+		"message":     types.StringValue(approval.Message),
+		"roles":       roles,
+		"approved_by": types.StringValue(approval.ApprovedBy),
+		"approved_at": types.StringValue(approval.ApprovedAt),
+		"decision":    types.StringValue(approval.Decision),
+	})
+	diags.Append(d...)
+
+	return o
+}
+
+// toDynamicValue converts a decoded JSON value to a dynamic value keeping its type: objects, tuples, strings, numbers and bools.
+func toDynamicValue(value any) (basetypes.DynamicValue, error) {
+	if value == nil {
+		return types.DynamicNull(), nil
+	}
+	v, err := toAttrValue(value)
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+
+	return types.DynamicValue(v), nil
+}
+
+func toAttrValue(value any) (attr.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), nil
+	case int64:
+		return types.NumberValue(new(big.Float).SetInt64(v)), nil
+	case int:
+		return types.NumberValue(new(big.Float).SetInt64(int64(v))), nil
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		return types.NumberValue(f), nil
+	case []any:
+		elemTypes := make([]attr.Type, len(v))
+		elems := make([]attr.Value, len(v))
+		for index, elem := range v {
+			e, err := toAttrValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes[index] = e.Type(context.Background())
+			elems[index] = e
+		}
+		t, d := types.TupleValue(elemTypes, elems)
+		if d.HasError() {
+			return nil, fmt.Errorf("error converting list: %v", d)
+		}
+		return t, nil
+	case map[string]any:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for key, elem := range v {
+			e, err := toAttrValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[key] = e.Type(context.Background())
+			attrs[key] = e
+		}
+		o, d := types.ObjectValue(attrTypes, attrs)
+		if d.HasError() {
+			return nil, fmt.Errorf("error converting object: %v", d)
+		}
+		return o, nil
+	default:
+		return types.StringValue(fmt.Sprintf("%v", v)), nil
+	}
+}
+
+// toStringMapValue converts a map to a map of strings, non string values are formatted.
+func toStringMapValue(ctx context.Context, diags *diag.Diagnostics, m map[string]any) basetypes.MapValue {
+	if m == nil {
+		return types.MapNull(types.StringType)
+	}
+	values := make(map[string]string, len(m))
+	for key, value := range m {
+		if str, ok := value.(string); ok {
+			values[key] = str
+		} else {
+			values[key] = fmt.Sprintf("%v", value)
+		}
+	}
+
+	v, d := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+
+	return v
+}
+
+// stringOrNull returns a null value for an empty string when the current value is null, to avoid a diff with unset attributes.
+func stringOrNull(value string, current types.String) types.String {
+	if value == "" && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// stringListOrNull returns a null value for an empty list when the current value is null, to avoid a diff with unset attributes.
+func stringListOrNull(ctx context.Context, diags *diag.Diagnostics, values []string, current types.List) types.List {
+	if len(values) == 0 && current.IsNull() {
+		return types.ListNull(types.StringType)
+	}
+	if values == nil {
+		values = []string{}
+	}
+	list, d := types.ListValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+
+	return list
+}
+
+// jsonEqual tells whether two JSON strings encode the same value.
+func jsonEqual(a string, b string) bool {
+	var va, vb any
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// jsonStringOrNull encodes value as a JSON string, null when value is nil.
+func jsonStringOrNull(value any) (types.String, error) {
+	if value == nil {
+		return types.StringNull(), nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	return types.StringValue(string(encoded)), nil
+}
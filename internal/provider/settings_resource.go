@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &SettingsResource{}
+	_ resource.ResourceWithConfigure   = &SettingsResource{}
+	_ resource.ResourceWithImportState = &SettingsResource{}
+)
+
+// NewSettingsResource is a helper function to simplify the provider implementation.
+func NewSettingsResource() resource.Resource {
+	return &SettingsResource{
+		config: resourceOrDataSourceConfig{
+			name: "settings",
+		},
+	}
+}
+
+// SettingsResource is the resource implementation.
+type SettingsResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SettingsResourceModel maps the resource schema data.
+type SettingsResourceModel struct {
+	CxProfileName types.String `tfsdk:"cx_profile_name"`
+	ID            types.String `tfsdk:"id"`
+	URL           types.String `tfsdk:"url"`
+	MailServer    types.String `tfsdk:"mail_server"`
+	MailPort      types.Int64  `tfsdk:"mail_port"`
+	MailSecure    types.Bool   `tfsdk:"mail_secure"`
+	MailUsername  types.String `tfsdk:"mail_username"`
+	MailPassword  types.String `tfsdk:"mail_password"`
+	MailFrom      types.String `tfsdk:"mail_from"`
+	TestMailTo    types.String `tfsdk:"test_mail_to"`
+}
+
+// Metadata returns the resource type name.
+func (r *SettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Mail and server URL settings of Ansible Forms. There is a single instance per connection profile, deleting it leaves the settings in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Connection profile name of the settings.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Public URL of Ansible Forms, used in the links of approval and notification mails.",
+			},
+			"mail_server": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Host name or IP address of the SMTP server.",
+			},
+			"mail_port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(25),
+				MarkdownDescription: "Port of the SMTP server. Defaults to 25.",
+			},
+			"mail_secure": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether to connect to the SMTP server with TLS.",
+			},
+			"mail_username": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "User name to authenticate to the SMTP server, leave empty for anonymous access.",
+			},
+			"mail_password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password to authenticate to the SMTP server. Ansible Forms does not return it, so changes made outside of Terraform are not detected. The current password is kept when not set.",
+			},
+			"mail_from": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Sender address of the mails.",
+			},
+			"test_mail_to": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Send a test mail to this address each time Terraform saves the settings, the apply fails if the mail cannot be sent. Changing it alone sends a new test mail.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// settings builds the settings from the model.
+func (m *SettingsResourceModel) settings() interfaces.SettingsDataModel {
+	return interfaces.SettingsDataModel{
+		URL:          m.URL.ValueString(),
+		MailServer:   m.MailServer.ValueString(),
+		MailPort:     m.MailPort.ValueInt64(),
+		MailSecure:   m.MailSecure.ValueBool(),
+		MailUsername: m.MailUsername.ValueString(),
+		MailPassword: m.MailPassword.ValueString(),
+		MailFrom:     m.MailFrom.ValueString(),
+	}
+}
+
+// save saves the settings and sends a test mail when requested.
+func (r *SettingsResource) save(ctx context.Context, errorHandler *utils.ErrorHandler, data *SettingsResourceModel) error {
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return err
+	}
+	if err = interfaces.UpdateSettings(errorHandler, *client, data.settings()); err != nil {
+		return err
+	}
+	data.ID = data.CxProfileName
+	tflog.Debug(ctx, fmt.Sprintf("saved settings for %s", data.CxProfileName.ValueString()))
+
+	if data.TestMailTo.ValueString() != "" {
+		if err = interfaces.SendTestMail(errorHandler, *client, data.TestMailTo.ValueString()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Create a new resource.
+func (r *SettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := r.save(ctx, errorHandler, data); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information, reporting changes made outside of Terraform.
+func (r *SettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	settings, err := interfaces.GetSettings(errorHandler, *client)
+	if err != nil {
+		return
+	}
+
+	// the mail password is not returned in clear text, keep the one from the state
+	data.ID = data.CxProfileName
+	data.URL = types.StringValue(settings.URL)
+	data.MailServer = types.StringValue(settings.MailServer)
+	data.MailPort = types.Int64Value(settings.MailPort)
+	data.MailSecure = types.BoolValue(settings.MailSecure)
+	data.MailUsername = types.StringValue(settings.MailUsername)
+	data.MailFrom = types.StringValue(settings.MailFrom)
+
+	tflog.Debug(ctx, fmt.Sprintf("read settings for %s", data.CxProfileName.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *SettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	if err := r.save(ctx, errorHandler, data); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the settings from the state, they stay in place as Ansible Forms always has settings.
+func (r *SettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("settings of %s left in place", data.CxProfileName.ValueString()))
+}
+
+// ImportState imports the settings, using the connection profile name as ID.
+func (r *SettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cx_profile_name"), req, resp)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsResourceConfig("smtp1.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_settings.settings", "id", "cluster4"),
+					resource.TestCheckResourceAttr("ansible-forms_settings.settings", "url", "https://ansibleforms.example.com"),
+					resource.TestCheckResourceAttr("ansible-forms_settings.settings", "mail_port", "25"),
+					resource.TestCheckResourceAttr("ansible-forms_settings.settings", "mail_server", "smtp1.example.com")),
+			},
+			{
+				Config: testAccSettingsResourceConfig("smtp2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_settings.settings", "mail_server", "smtp2.example.com")),
+			},
+			{
+				ResourceName:            "ansible-forms_settings.settings",
+				ImportState:             true,
+				ImportStateId:           "cluster4",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mail_password"},
+			},
+		},
+	})
+}
+
+func testAccSettingsResourceConfig(mailServer string) string {
+	host := os.Getenv("TF_ACC_ANSIBLE_FORMS_HOST")
+	admin := os.Getenv("TF_ACC_ANSIBLE_FORMS_USER")
+	password := os.Getenv("TF_ACC_ANSIBLE_FORMS_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_ANSIBLE_FORMS_HOST, TF_ACC_ANSIBLE_FORMS_USER, and TF_ACC_ANSIBLE_FORMS_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "ansible-forms" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "ansible-forms_settings" "settings" {
+  cx_profile_name = "cluster4"
+  url             = "https://ansibleforms.example.com"
+  mail_server     = "%s"
+  mail_username   = "tf_acc"
+  mail_password   = "mypassword"
+  mail_from       = "tf_acc@example.com"
+}`, host, admin, password, mailServer)
+}
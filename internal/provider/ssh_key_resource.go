@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/interfaces"
+	"terraform-provider-ansible-forms/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &SSHKeyResource{}
+	_ resource.ResourceWithConfigure   = &SSHKeyResource{}
+	_ resource.ResourceWithImportState = &SSHKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &SSHKeyResource{}
+)
+
+// NewSSHKeyResource is a helper function to simplify the provider implementation.
+func NewSSHKeyResource() resource.Resource {
+	return &SSHKeyResource{
+		config: resourceOrDataSourceConfig{
+			name: "ssh_key",
+		},
+	}
+}
+
+// SSHKeyResource is the resource implementation.
+type SSHKeyResource struct {
+	config resourceOrDataSourceConfig
+}
+
+// SSHKeyResourceModel maps the resource schema data.
+type SSHKeyResourceModel struct {
+	CxProfileName       types.String `tfsdk:"cx_profile_name"`
+	ID                  types.String `tfsdk:"id"`
+	PrivateKey          types.String `tfsdk:"private_key"`
+	PrivateKeyWO        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWOVersion types.Int64  `tfsdk:"private_key_wo_version"`
+	PublicKey           types.String `tfsdk:"public_key"`
+}
+
+// Metadata returns the resource type name.
+func (r *SSHKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.config.name
+}
+
+// Schema defines the schema for the resource.
+func (r *SSHKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SSH key Ansible Forms uses to connect to target hosts. There is a single instance per connection profile, deleting it leaves the key in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"cx_profile_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Connection profile name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Connection profile name of the SSH key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "PEM encoded private key, stored in the Terraform state. Prefer `private_key_wo` with Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("private_key_wo")),
+				},
+			},
+			"private_key_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "PEM encoded private key, never stored in the Terraform state (requires Terraform 1.11 or later). Change `private_key_wo_version` to update it.",
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of `private_key_wo`, the key is only sent when the version changes.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Public key derived from the private key, to add to the authorized_keys of target hosts.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SSHKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	config, ok := req.ProviderData.(Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+	}
+	r.config.providerConfig = config
+}
+
+// ModifyPlan marks the public key unknown when the private key changes.
+func (r *SSHKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state *SSHKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.PrivateKey.Equal(state.PrivateKey) || !plan.PrivateKeyWOVersion.Equal(state.PrivateKeyWOVersion) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public_key"), types.StringUnknown())...)
+	}
+}
+
+// save sends the private key and reads back the public key.
+func (r *SSHKeyResource) save(errorHandler *utils.ErrorHandler, data *SSHKeyResourceModel, privateKey types.String) error {
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return err
+	}
+	if !privateKey.IsNull() {
+		if err = interfaces.UpdateSSHKey(errorHandler, *client, privateKey.ValueString()); err != nil {
+			return err
+		}
+	}
+	key, err := interfaces.GetSSHKey(errorHandler, *client)
+	if err != nil {
+		return err
+	}
+	data.ID = data.CxProfileName
+	data.PublicKey = types.StringValue(key.PublicKey)
+
+	return nil
+}
+
+// Create a new resource.
+func (r *SSHKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SSHKeyResourceModel
+	var privateKeyWO types.String
+
+	// Read Terraform plan data into the model, write-only values are only available in the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key_wo"), &privateKeyWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	privateKey := data.PrivateKey
+	if !privateKeyWO.IsNull() {
+		privateKey = privateKeyWO
+	}
+	if err := r.save(errorHandler, data, privateKey); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *SSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SSHKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	client, err := getRestClient(errorHandler, r.config, data.CxProfileName)
+	if err != nil {
+		// error reporting done inside NewClient
+		return
+	}
+
+	key, err := interfaces.GetSSHKey(errorHandler, *client)
+	if err != nil {
+		return
+	}
+
+	// the private key is not returned by Ansible Forms, keep the one from the state
+	data.ID = data.CxProfileName
+	data.PublicKey = types.StringValue(key.PublicKey)
+
+	tflog.Debug(ctx, fmt.Sprintf("read SSH key for %s", data.CxProfileName.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *SSHKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SSHKeyResourceModel
+	var state *SSHKeyResourceModel
+	var privateKeyWO types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key_wo"), &privateKeyWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errorHandler := utils.NewErrorHandler(ctx, &resp.Diagnostics)
+	// the private key is not returned by Ansible Forms, only send it when it changes
+	privateKey := types.StringNull()
+	if !data.PrivateKey.Equal(state.PrivateKey) {
+		privateKey = data.PrivateKey
+	} else if !privateKeyWO.IsNull() && !data.PrivateKeyWOVersion.Equal(state.PrivateKeyWOVersion) {
+		privateKey = privateKeyWO
+	}
+	if err := r.save(errorHandler, data, privateKey); err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the SSH key from the state, the key stays in place as Ansible Forms always needs one.
+func (r *SSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("SSH key of %s left in place", data.CxProfileName.ValueString()))
+}
+
+// ImportState imports the SSH key, using the connection profile name as ID.
+func (r *SSHKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cx_profile_name"), req, resp)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResourceConfig("first@example.com", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_group.group", "name", "tf_acc_group"),
+					resource.TestCheckResourceAttrSet("ansible-forms_group.group", "id"),
+					resource.TestCheckResourceAttr("ansible-forms_user.user", "username", "tf_acc_user"),
+					resource.TestCheckResourceAttr("ansible-forms_user.user", "email", "first@example.com"),
+					resource.TestCheckResourceAttrPair("ansible-forms_user.user", "group_id", "ansible-forms_group.group", "id"),
+					resource.TestCheckNoResourceAttr("ansible-forms_user.user", "password_wo"),
+					resource.TestCheckResourceAttrSet("ansible-forms_user.user", "id")),
+			},
+			{
+				// rotate the password
+				Config: testAccUserResourceConfig("second@example.com", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ansible-forms_user.user", "email", "second@example.com"),
+					resource.TestCheckResourceAttr("ansible-forms_user.user", "password_wo_version", "2")),
+			},
+			{
+				ResourceName:            "ansible-forms_user.user",
+				ImportState:             true,
+				ImportStateId:           "tf_acc_user,cluster4",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_wo_version"},
+			},
+			{
+				ResourceName:      "ansible-forms_group.group",
+				ImportState:       true,
+				ImportStateId:     "tf_acc_group,cluster4",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccUserResourceConfig(email string, passwordVersion int) string {
+	host := os.Getenv("TF_ACC_ANSIBLE_FORMS_HOST")
+	admin := os.Getenv("TF_ACC_ANSIBLE_FORMS_USER")
+	password := os.Getenv("TF_ACC_ANSIBLE_FORMS_PASS")
+	if host == "" || admin == "" || password == "" {
+		fmt.Println("TF_ACC_ANSIBLE_FORMS_HOST, TF_ACC_ANSIBLE_FORMS_USER, and TF_ACC_ANSIBLE_FORMS_PASS must be set for acceptance tests")
+		os.Exit(1)
+	}
+	return fmt.Sprintf(`
+provider "ansible-forms" {
+ connection_profiles = [
+    {
+      name = "cluster4"
+      hostname = "%s"
+      username = "%s"
+      password = "%s"
+      validate_certs = false
+    },
+  ]
+}
+
+resource "ansible-forms_group" "group" {
+  cx_profile_name = "cluster4"
+  name            = "tf_acc_group"
+}
+
+resource "ansible-forms_user" "user" {
+  cx_profile_name     = "cluster4"
+  username            = "tf_acc_user"
+  email               = "%s"
+  group_id            = ansible-forms_group.group.id
+  password_wo         = "mypassword%d"
+  password_wo_version = %d
+}`, host, admin, password, email, passwordVersion, passwordVersion)
+}
@@ -3,6 +3,7 @@ package httpclient
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -27,6 +28,8 @@ type HTTPProfile struct {
 	Username      string
 	Password      string
 	ValidateCerts bool
+	// LogBodies logs the bodies of requests and responses at DEBUG level.
+	LogBodies bool
 }
 
 // NewClient creates a new HTTP client
@@ -55,7 +58,13 @@ func (c *HTTPClient) Do(baseURL string, req *Request) (int, []byte, error) {
 	if err != nil {
 		return statusCode, nil, err
 	}
-	tflog.Debug(c.ctx, fmt.Sprintf("sending: %s %s", httpReq.Method, httpReq.URL.String()), map[string]any{"body": req.Body})
+	if c.cxProfile.LogBodies {
+		// the body is logged as a JSON string so that the string masks of the logger apply to it
+		body, _ := json.Marshal(req.Body)
+		tflog.Debug(c.ctx, fmt.Sprintf("sending: %s %s", httpReq.Method, httpReq.URL.String()), map[string]any{"body": string(body)})
+	} else {
+		tflog.Debug(c.ctx, fmt.Sprintf("sending: %s %s", httpReq.Method, httpReq.URL.String()))
+	}
 	httpRes, err := c.httpClient.Do(httpReq)
 	if httpRes != nil {
 		statusCode = httpRes.StatusCode
@@ -82,7 +91,11 @@ func (c *HTTPClient) Do(baseURL string, req *Request) (int, []byte, error) {
 		return httpRes.StatusCode, nil, fmt.Errorf("no result returned in REST response.  statusCode %d", statusCode)
 	}
 
-	tflog.Debug(c.ctx, fmt.Sprintf("received: %s %s %d", req.Method, httpReq.URL.String(), statusCode), map[string]any{"res": string(body)})
+	if c.cxProfile.LogBodies {
+		tflog.Debug(c.ctx, fmt.Sprintf("received: %s %s %d", req.Method, httpReq.URL.String(), statusCode), map[string]any{"res": string(body)})
+	} else {
+		tflog.Debug(c.ctx, fmt.Sprintf("received: %s %s %d", req.Method, httpReq.URL.String(), statusCode), map[string]any{"size": len(body)})
+	}
 
 	return httpRes.StatusCode, body, nil
 }
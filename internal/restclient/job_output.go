@@ -0,0 +1,123 @@
+package restclient
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// JobLogSubsystem is the tflog subsystem logging the output of running jobs, its level is set with TF_LOG_PROVIDER_ANSIBLE_FORMS_JOB.
+const JobLogSubsystem = "ansible_forms_job"
+
+// taskPattern matches the header of an Ansible task in the output of a job.
+var taskPattern = regexp.MustCompile(`^TASK \[(.+?)\]`)
+
+// jobOutputStream logs the output of a job as it grows, each line being logged once.
+type jobOutputStream struct {
+	ctx              context.Context
+	id               int64
+	form             string
+	offset           int   // length of the output already logged
+	counter          int64 // counter of the job when the output was last read
+	task             string
+	started          time.Time
+	progressInterval time.Duration
+	lastProgress     time.Time
+}
+
+// newJobOutputStream creates a stream for job id, a progress summary is logged every progressInterval when not zero.
+func newJobOutputStream(ctx context.Context, id int64, progressInterval time.Duration) *jobOutputStream {
+	now := time.Now()
+	subsystemCtx := tflog.NewSubsystem(ctx, JobLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", "ANSIBLE_FORMS_JOB"))
+	return &jobOutputStream{
+		ctx:              MaskSubsystem(ctx, subsystemCtx, JobLogSubsystem),
+		id:               id,
+		started:          now,
+		progressInterval: progressInterval,
+		lastProgress:     now,
+	}
+}
+
+// prefix identifies the job in the logged lines.
+func (s *jobOutputStream) prefix() string {
+	if s.form == "" {
+		return fmt.Sprintf("job %d: ", s.id)
+	}
+	return fmt.Sprintf("job %d (%s): ", s.id, s.form)
+}
+
+// output returns the new output of the job, or "" when it did not change since the last update.
+func (s *jobOutputStream) output(jobData map[string]any) string {
+	if form, ok := jobData["formName"].(string); ok && form != "" {
+		s.form = form
+	}
+	counter := s.counter
+	if value, ok := jobData["counter"].(float64); ok {
+		counter = int64(value)
+	}
+	if counter < s.counter {
+		// the output was reset
+		s.offset = 0
+	}
+	s.counter = counter
+
+	raw, _ := jobData["output"].(string)
+	output := html.UnescapeString(bluemonday.StrictPolicy().Sanitize(raw))
+	if len(output) < s.offset {
+		s.offset = 0
+	}
+
+	return output[s.offset:]
+}
+
+// log logs the lines of text and records the current task.
+func (s *jobOutputStream) log(text string) {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, " *\r")
+		if line == "" {
+			continue
+		}
+		if match := taskPattern.FindStringSubmatch(line); match != nil {
+			s.task = match[1]
+		}
+		tflog.SubsystemInfo(s.ctx, JobLogSubsystem, s.prefix()+line)
+	}
+}
+
+// update logs the complete lines added to the output of the job since the last update.
+func (s *jobOutputStream) update(jobData map[string]any) {
+	text := s.output(jobData)
+	end := strings.LastIndex(text, "\n")
+	if end < 0 {
+		// wait for the line to be complete
+		return
+	}
+	s.log(text[:end])
+	s.offset += end + 1
+}
+
+// flush logs the remaining output of a job that is no longer running, the last line may not end with a newline.
+func (s *jobOutputStream) flush(jobData map[string]any) {
+	text := s.output(jobData)
+	s.log(text)
+	s.offset += len(text)
+}
+
+// progress logs a summary of the job when progressInterval elapsed since the last one.
+func (s *jobOutputStream) progress(status string) {
+	if s.progressInterval <= 0 || time.Since(s.lastProgress) < s.progressInterval {
+		return
+	}
+	s.lastProgress = time.Now()
+	msg := fmt.Sprintf("%s%s for %s", s.prefix(), status, time.Since(s.started).Round(time.Second))
+	if s.task != "" {
+		msg += ", current task: " + s.task
+	}
+	tflog.SubsystemInfo(s.ctx, JobLogSubsystem, msg)
+}
@@ -0,0 +1,127 @@
+package restclient
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestJobOutputStream_update(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_ANSIBLE_FORMS_JOB", "INFO")
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	stream := newJobOutputStream(ctx, 12, 0)
+
+	tests := []struct {
+		name   string
+		data   map[string]any
+		flush  bool
+		want   []string
+		offset int
+	}{
+		{
+			name:   "partial line is not logged",
+			data:   map[string]any{"formName": "demo", "counter": float64(1), "output": "PLAY [all]"},
+			want:   nil,
+			offset: 0,
+		},
+		{
+			name:   "complete lines are logged once",
+			data:   map[string]any{"formName": "demo", "counter": float64(2), "output": "PLAY [all]\nTASK [create volume] ****\nok: [localhost]"},
+			want:   []string{"job 12 (demo): PLAY [all]", "job 12 (demo): TASK [create volume]"},
+			offset: len("PLAY [all]\nTASK [create volume] ****\n"),
+		},
+		{
+			name:   "remaining output is logged on flush",
+			data:   map[string]any{"formName": "demo", "counter": float64(3), "output": "PLAY [all]\nTASK [create volume] ****\nok: [localhost]"},
+			flush:  true,
+			want:   []string{"job 12 (demo): ok: [localhost]"},
+			offset: len("PLAY [all]\nTASK [create volume] ****\nok: [localhost]"),
+		},
+		{
+			name:   "output is logged again after a reset",
+			data:   map[string]any{"formName": "demo", "counter": float64(1), "output": "PLAY [again]\n"},
+			want:   []string{"job 12 (demo): PLAY [again]"},
+			offset: len("PLAY [again]\n"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs.Reset()
+			if tt.flush {
+				stream.flush(tt.data)
+			} else {
+				stream.update(tt.data)
+			}
+			entries, err := tflogtest.MultilineJSONDecode(&logs)
+			if err != nil {
+				t.Fatalf("unable to decode logs: %s", err)
+			}
+			var got []string
+			for _, entry := range entries {
+				if entry["@module"] != "provider."+JobLogSubsystem {
+					t.Errorf("unexpected module %v", entry["@module"])
+				}
+				got = append(got, entry["@message"].(string))
+			}
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("update() logged %q, want %q", got, tt.want)
+			}
+			if stream.offset != tt.offset {
+				t.Errorf("update() offset = %d, want %d", stream.offset, tt.offset)
+			}
+		})
+	}
+	if stream.task != "create volume" {
+		t.Errorf("task = %q, want %q", stream.task, "create volume")
+	}
+}
+
+func TestJobOutputStream_progress(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_ANSIBLE_FORMS_JOB", "INFO")
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	stream := newJobOutputStream(ctx, 12, 0)
+	stream.progress("running")
+	if logs.Len() != 0 {
+		t.Errorf("progress() logged %q without interval", logs.String())
+	}
+
+	stream = newJobOutputStream(ctx, 12, time.Minute)
+	stream.form = "demo"
+	stream.task = "create volume"
+	stream.progress("running")
+	if logs.Len() != 0 {
+		t.Errorf("progress() logged %q before the interval elapsed", logs.String())
+	}
+	stream.lastProgress = stream.lastProgress.Add(-time.Minute)
+	stream.progress("running")
+	if !strings.Contains(logs.String(), "job 12 (demo): running for ") || !strings.Contains(logs.String(), "current task: create volume") {
+		t.Errorf("progress() logged %q", logs.String())
+	}
+}
+
+func TestJobOutputStream_maskStrings(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_ANSIBLE_FORMS_JOB", "INFO")
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	ctx = MaskStrings(ctx, "s3cret", "")
+	stream := newJobOutputStream(ctx, 12, 0)
+
+	stream.flush(map[string]any{"formName": "demo", "counter": float64(1), "output": "login with s3cret"})
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatalf("unable to decode logs: %s", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("flush() logged %d entries, want 1", len(entries))
+	}
+	if got, want := entries[0]["@message"], "job 12 (demo): login with ***"; got != want {
+		t.Errorf("flush() logged %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,90 @@
+package restclient
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// secretFieldKeys are the keys of the log fields whose values are masked by MaskSecrets.
+var secretFieldKeys = []string{"password", "Password", "token", "Token", "authorization", "Authorization", "credentials", "secret"}
+
+// secretPatterns match the secrets masked by MaskSecrets in the logged messages and string fields.
+var secretPatterns = []*regexp.Regexp{
+	// values of secret keys in JSON and in Go maps formatted with %#v, such as "password":"..."
+	regexp.MustCompile(`(?i)"[^"]*(?:password|passwd|secret|token|api_?key|private_?key|authorization)[^"]*"\s*:\s*"(?:[^"\\]|\\.)*"`),
+	// values of secret fields in Go structs formatted with %#v, such as Password:"..."
+	regexp.MustCompile(`(?i)\b\w*(?:password|secret|token)\w*:"(?:[^"\\]|\\.)*"`),
+	// credentials of a job, in JSON and in Go maps formatted with %#v
+	regexp.MustCompile(`(?i)"credentials"\s*:\s*(?:map\[string\](?:interface \{\}|string))?\{[^{}]*\}`),
+	// basic and bearer authorization headers
+	regexp.MustCompile(`\b(?:Basic|Bearer) [A-Za-z0-9._~+/=-]{8,}`),
+}
+
+// logMasksKey is the context key of the masks of a context.
+type logMasksKey struct{}
+
+// logMasks records the masks of a context, to apply them to the subsystem loggers.
+type logMasks struct {
+	strings []string
+	secrets bool
+}
+
+// MaskStrings returns a context masking values in the messages and string fields logged with it.
+// The values are also masked in the job output logged with the JobLogSubsystem logger while waiting for a job.
+func MaskStrings(ctx context.Context, values ...string) context.Context {
+	var masked []string
+	for _, value := range values {
+		if value != "" {
+			masked = append(masked, value)
+		}
+	}
+	if len(masked) == 0 {
+		return ctx
+	}
+	ctx = tflog.MaskMessageStrings(ctx, masked...)
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, masked...)
+
+	masks := getLogMasks(ctx)
+	masks.strings = append(masks.strings, masked...)
+	return context.WithValue(ctx, logMasksKey{}, masks)
+}
+
+// MaskSecrets returns a context masking secrets in the logs: the values of fields with a secret key such as password, token
+// or credentials, the values of secret keys in logged JSON documents and Go values, and authorization headers.
+// The secrets are also masked in the job output logged with the JobLogSubsystem logger while waiting for a job.
+func MaskSecrets(ctx context.Context) context.Context {
+	masks := getLogMasks(ctx)
+	if masks.secrets {
+		return ctx
+	}
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, secretFieldKeys...)
+	ctx = tflog.MaskMessageRegexes(ctx, secretPatterns...)
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, secretPatterns...)
+
+	masks.secrets = true
+	return context.WithValue(ctx, logMasksKey{}, masks)
+}
+
+// MaskSubsystem applies the masks of ctx to the subsystem logger of subsystemCtx, as the masks of the root logger do not apply to subsystems.
+func MaskSubsystem(ctx context.Context, subsystemCtx context.Context, subsystem string) context.Context {
+	masks := getLogMasks(ctx)
+	if len(masks.strings) > 0 {
+		subsystemCtx = tflog.SubsystemMaskMessageStrings(subsystemCtx, subsystem, masks.strings...)
+		subsystemCtx = tflog.SubsystemMaskAllFieldValuesStrings(subsystemCtx, subsystem, masks.strings...)
+	}
+	if masks.secrets {
+		subsystemCtx = tflog.SubsystemMaskFieldValuesWithFieldKeys(subsystemCtx, subsystem, secretFieldKeys...)
+		subsystemCtx = tflog.SubsystemMaskMessageRegexes(subsystemCtx, subsystem, secretPatterns...)
+		subsystemCtx = tflog.SubsystemMaskAllFieldValuesRegexes(subsystemCtx, subsystem, secretPatterns...)
+	}
+	return subsystemCtx
+}
+
+// getLogMasks returns a copy of the masks of ctx.
+func getLogMasks(ctx context.Context) logMasks {
+	masks, _ := ctx.Value(logMasksKey{}).(logMasks)
+	masks.strings = append([]string(nil), masks.strings...)
+	return masks
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"html"
-	"math/big"
 	"net/url"
 	"strings"
 	"time"
@@ -23,6 +22,10 @@ const (
 	AnsibleStatusRunning = "info"
 	AnsibleStatusSuccess = "success"
 	AnsibleStatusFailure = "error" // failure was not returned but maybe because of testing
+	// AnsibleJobStatusApprove is the status of a job waiting for approval.
+	AnsibleJobStatusApprove = "approve"
+	// AnsibleJobStatusRejected is the status of a job whose approval was rejected.
+	AnsibleJobStatusRejected = "rejected"
 )
 
 // ConnectionProfile describes out to reach a cluster or svm.
@@ -34,6 +37,8 @@ type ConnectionProfile struct {
 	Password              string
 	ValidateCerts         bool
 	MaxConcurrentRequests int
+	// LogBodies logs the bodies of requests and responses at DEBUG level, with secrets masked.
+	LogBodies bool
 }
 
 // RestClient to interact with the Ansible Forms REST API.
@@ -44,13 +49,18 @@ type RestClient struct {
 	httpClient            httpclient.HTTPClient
 	requestSlots          chan int
 	mode                  string
-	responses             []MockResponse
+	responses             *mockResponses
 	jobCompletionTimeOut  int
+	jobProgressInterval   time.Duration
+	checkLoopInterval     time.Duration
 	tag                   string
 }
 
 // NewClient creates a new REST client and a supporting HTTP client.
-func NewClient(ctx context.Context, cxProfile ConnectionProfile, tag string, jobCompletionTimeOut int) (*RestClient, error) {
+// While waiting for a job, a progress summary is logged every jobProgressInterval seconds when not zero.
+// Secrets, including the password of the profile, are masked in the logs of the client.
+func NewClient(ctx context.Context, cxProfile ConnectionProfile, tag string, jobCompletionTimeOut int, jobProgressInterval int) (*RestClient, error) {
+	ctx = MaskSecrets(MaskStrings(ctx, cxProfile.Password))
 	var httpProfile httpclient.HTTPProfile
 	err := mapstructure.Decode(cxProfile, &httpProfile)
 	if err != nil {
@@ -71,6 +81,8 @@ func NewClient(ctx context.Context, cxProfile ConnectionProfile, tag string, job
 		mode:                  "prod",
 		requestSlots:          make(chan int, maxConcurrentRequests),
 		jobCompletionTimeOut:  jobCompletionTimeOut,
+		jobProgressInterval:   time.Duration(jobProgressInterval) * time.Second,
+		checkLoopInterval:     CheckLoopInterval,
 		tag:                   tag,
 	}
 
@@ -78,7 +90,7 @@ func NewClient(ctx context.Context, cxProfile ConnectionProfile, tag string, job
 }
 
 // CallCreateMethod returns response from POST results.  An error is reported if an error is received.
-func (r *RestClient) CallCreateMethod(baseURL string, query *RestQuery, body map[string]any) (string, RestResponse, error) {
+func (r *RestClient) CallCreateMethod(baseURL string, query *RestQuery, body map[string]any) (int, RestResponse, error) {
 	if query == nil {
 		query = r.NewQuery()
 	}
@@ -87,51 +99,110 @@ func (r *RestClient) CallCreateMethod(baseURL string, query *RestQuery, body map
 	statusCode, response, err := r.callAPIMethod("POST", baseURL, query, body)
 	if err != nil {
 		tflog.Debug(r.ctx, fmt.Sprintf("CallCreateMethod request failed %#v", statusCode))
-		return "", RestResponse{}, err
+		return statusCode, RestResponse{}, err
 	}
 
+	return statusCode, response, err
+}
+
+// JobWaitOptions controls how WaitForJob behaves when a job is waiting for approval.
+type JobWaitOptions struct {
+	// ApprovalTimeout is how long to wait for a pending approval. When zero, WaitForJob returns as soon as the job is pending approval.
+	ApprovalTimeout time.Duration
+	// FailOnPendingApproval reports an error as soon as the job is pending approval.
+	FailOnPendingApproval bool
+	// Timeout is how long to wait for the job to complete, not counting the time spent waiting for approval. CheckLoopTimeout is used when zero.
+	Timeout time.Duration
+}
+
+// WaitForJob polls an Ansible Forms job until it completes, fails or is pending approval, and returns its status and last record.
+// The new lines of the job output are logged at each poll with the JobLogSubsystem logger.
+func (r *RestClient) WaitForJob(id int64, options JobWaitOptions) (string, map[string]any, error) {
 	status := AnsibleStatusRunning
-	resp_id := response.Records[0]["data"].(map[string]any)["output"].(map[string]any)["id"]
-	id, _ := big.NewFloat(resp_id.(float64)).Int64()
-	timeOutTimer := time.NewTimer(CheckLoopTimeout)
-check:
+	timeout := options.Timeout
+	if timeout <= 0 {
+		timeout = CheckLoopTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	var approvalDeadline time.Time
+	stream := newJobOutputStream(r.ctx, id, r.jobProgressInterval)
 	for {
-		select {
-		case <-time.After(CheckLoopInterval):
-			statusCode, restInfo, err := r.GetNilOrOneRecord(fmt.Sprintf("job/%d", id), nil, nil)
-			if err != nil {
-				return "", RestResponse{}, fmt.Errorf("error on GET job/%d: %s, statusCode %d", id, err, statusCode)
+		<-time.After(r.checkLoopInterval)
+		statusCode, restInfo, err := r.GetNilOrOneRecord(fmt.Sprintf("job/%d", id), nil, nil)
+		if err != nil {
+			return "", nil, fmt.Errorf("error on GET job/%d: %s, statusCode %d", id, err, statusCode)
+		}
+		status, _ = restInfo["status"].(string)
+		jobData, _ := restInfo["data"].(map[string]any)
+		jobStatus, _ := jobData["status"].(string)
+		if status == AnsibleStatusRunning {
+			stream.update(jobData)
+		} else {
+			stream.flush(jobData)
+		}
+		switch {
+		case jobStatus == AnsibleJobStatusApprove:
+			roles := approvalRoles(jobData)
+			if options.FailOnPendingApproval {
+				return AnsibleJobStatusApprove, restInfo, fmt.Errorf("job %d is pending approval by %s", id, roles)
+			}
+			if options.ApprovalTimeout <= 0 {
+				tflog.Info(r.ctx, fmt.Sprintf("job %d is pending approval by %s, not waiting for approval", id, roles))
+				return AnsibleJobStatusApprove, restInfo, nil
 			}
-			status = restInfo["status"].(string)
-			switch status {
-			case AnsibleStatusRunning:
-				continue
-			case AnsibleStatusSuccess:
-				break check
-			case AnsibleStatusFailure:
-				output := restInfo["data"].(map[string]any)["output"].(string)
-				text := errors.New(html.UnescapeString(bluemonday.StrictPolicy().Sanitize(output)))
-				return "", RestResponse{}, fmt.Errorf("when checking job status, Ansible returned failure (details below):\n%w", text)
-			default:
-				return "", RestResponse{}, fmt.Errorf("when checking job status, Ansible returned an unexpected status - %s", status)
+			if approvalDeadline.IsZero() {
+				approvalDeadline = time.Now().Add(options.ApprovalTimeout)
 			}
-		case <-timeOutTimer.C:
+			if time.Now().After(approvalDeadline) {
+				return AnsibleJobStatusApprove, restInfo, fmt.Errorf("when waiting for approval of job %d by %s, loop timed-out [waiting time was longer than %s]", id, roles, options.ApprovalTimeout)
+			}
+			tflog.Info(r.ctx, fmt.Sprintf("job %d is pending approval by %s, waiting up to %s", id, roles, time.Until(approvalDeadline).Round(time.Second)))
+			// time spent waiting for approval does not count against the job completion timeout
+			deadline = time.Now().Add(timeout)
+			continue
+		case jobStatus == AnsibleJobStatusRejected:
+			return AnsibleJobStatusRejected, restInfo, fmt.Errorf("job %d was rejected", id)
+		case status == AnsibleStatusRunning:
+			stream.progress("running")
+		case status == AnsibleStatusSuccess:
+			return status, restInfo, nil
+		case status == AnsibleStatusFailure:
+			output, _ := jobData["output"].(string)
+			text := errors.New(html.UnescapeString(bluemonday.StrictPolicy().Sanitize(output)))
+			return status, restInfo, fmt.Errorf("when checking job status, Ansible returned failure (details below):\n%w", text)
+		default:
+			return status, restInfo, fmt.Errorf("when checking job status, Ansible returned an unexpected status - %s", status)
+		}
+		if time.Now().After(deadline) {
 			tflog.Debug(r.ctx, "job status check timed-out")
-			return "", RestResponse{}, fmt.Errorf("when checking job status, loop timed-out [running time was longer than %s]", CheckLoopTimeout)
+			return status, restInfo, fmt.Errorf("when checking job status, loop timed-out [running time was longer than %s]", timeout)
 		}
 	}
+}
+
+// approvalRoles describes who is expected to approve a job, based on the approval definition of its form.
+func approvalRoles(jobData map[string]any) string {
+	approval, _ := jobData["approval"].(map[string]any)
+	rawRoles, _ := approval["roles"].([]any)
+	roles := make([]string, 0, len(rawRoles))
+	for _, role := range rawRoles {
+		roles = append(roles, fmt.Sprintf("%v", role))
+	}
+	if len(roles) == 0 {
+		return "an approver"
+	}
 
-	return status, response, err
+	return "roles " + strings.Join(roles, ", ")
 }
 
-// CallUpdateMethod returns response from PATCH results.  An error is reported if an error is received.
+// CallUpdateMethod returns response from PUT results, Ansible Forms replaces objects on update.  An error is reported if an error is received.
 func (r *RestClient) CallUpdateMethod(baseURL string, query *RestQuery, body map[string]any) (int, RestResponse, error) {
 	if query == nil {
 		query = r.NewQuery()
 	}
 	// TODO: make this a connection parameter ?
 	query.Set("return_timeout", "60")
-	statusCode, response, err := r.callAPIMethod("PATCH", baseURL, query, body)
+	statusCode, response, err := r.callAPIMethod("PUT", baseURL, query, body)
 	if err != nil {
 		tflog.Debug(r.ctx, fmt.Sprintf("CallUpdateMethod request failed %#v", statusCode))
 		return statusCode, RestResponse{}, err
@@ -178,7 +249,7 @@ func (r *RestClient) GetNilOrOneRecord(baseURL string, query *RestQuery, body ma
 		return statusCode, nil, err
 	}
 	if response.NumRecords > 1 {
-		msg := fmt.Sprintf("received 2 or more records when only one is expected - statusCode %d, err=%#v, response=%#v", statusCode, err, response)
+		msg := fmt.Sprintf("received 2 or more records when only one is expected - statusCode %d, err=%#v, response=%s", statusCode, err, r.logBody(response))
 		tflog.Error(r.ctx, msg)
 		return statusCode, nil, errors.New(msg)
 	}
@@ -215,7 +286,7 @@ func (r *RestClient) Wait(uuid string) (int, RestResponse, error) {
 		}
 		var job Job
 		if err := mapstructure.Decode(response, &job); err != nil {
-			tflog.Error(r.ctx, fmt.Sprintf("Read job data - decode error: %s, data: %#v", err, response))
+			tflog.Error(r.ctx, fmt.Sprintf("Read job data - decode error: %s, data: %s", err, r.logBody(response)))
 			return statusCode, RestResponse{}, err
 		}
 		if job.State == "queued" || job.State == "running" || job.State == "paused" {
@@ -3,6 +3,8 @@ package restclient
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 )
 
 // MockResponse is used in Unit Testing to mock expected REST responses.
@@ -15,6 +17,12 @@ type MockResponse struct {
 	Err            error
 }
 
+// mockResponses holds the expected responses, shared by the copies of a mocked client as the client is passed by value.
+type mockResponses struct {
+	mutex     sync.Mutex
+	responses []MockResponse
+}
+
 // NewMockedRestClient is used in Unit Testing to mock expected REST responses.
 func NewMockedRestClient(responses []MockResponse) (*RestClient, error) {
 	cxProfile := ConnectionProfile{
@@ -22,28 +30,37 @@ func NewMockedRestClient(responses []MockResponse) (*RestClient, error) {
 		Username: "",
 		Password: "",
 	}
-	newRestClient, err := NewClient(context.Background(), cxProfile, "resource/version", 600)
+	newRestClient, err := NewClient(context.Background(), cxProfile, "resource/version", 600, 0)
 	if err != nil {
 		panic(err)
 	}
 	newRestClient.mode = "mock"
-	newRestClient.responses = responses
+	newRestClient.responses = &mockResponses{responses: responses}
+	// the mocked job status does not change over time, there is no need to wait between polls
+	newRestClient.checkLoopInterval = time.Millisecond
 
 	return newRestClient, nil
 }
 
 func (r *RestClient) mockCallAPIMethod(method string, baseURL string, query *RestQuery, body map[string]any) (int, RestResponse, error) {
-	if len(r.responses) == 0 {
+	r.responses.mutex.Lock()
+	defer r.responses.mutex.Unlock()
+	if len(r.responses.responses) == 0 {
 		panic(fmt.Sprintf("Unexpected request: %s %s", method, baseURL))
 	}
-	expectedResponse := r.responses[0]
+	expectedResponse := r.responses.responses[0]
 	if expectedResponse.ExpectedMethod != method || expectedResponse.ExpectedURL != baseURL {
-		if len(r.responses) == 0 {
-			panic(fmt.Sprintf("Unexpected request: %s %s, expecting %s %s", method, baseURL, expectedResponse.ExpectedMethod, expectedResponse.ExpectedURL))
-		}
+		panic(fmt.Sprintf("Unexpected request: %s %s, expecting %s %s", method, baseURL, expectedResponse.ExpectedMethod, expectedResponse.ExpectedURL))
 	}
 	// remove element now that we know it is consumed
-	r.responses = r.responses[1:]
+	r.responses.responses = r.responses.responses[1:]
 
 	return expectedResponse.StatusCode, expectedResponse.Response, expectedResponse.Err
 }
+
+// MockResponsesLeft returns the number of expected responses not consumed yet.
+func (r *RestClient) MockResponsesLeft() int {
+	r.responses.mutex.Lock()
+	defer r.responses.mutex.Unlock()
+	return len(r.responses.responses)
+}
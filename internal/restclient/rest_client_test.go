@@ -2,7 +2,9 @@ package restclient
 
 import (
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestRestClient_GetNilOrOneRecord(t *testing.T) {
@@ -68,3 +70,89 @@ func TestRestClient_GetNilOrOneRecord(t *testing.T) {
 		})
 	}
 }
+
+func TestRestClient_WaitForJob(t *testing.T) {
+	job := func(status string, jobStatus string) MockResponse {
+		data := map[string]any{"id": float64(1), "status": jobStatus, "output": "TASK [create share]", "approval": map[string]any{"roles": []any{"admin"}}}
+		return MockResponse{"GET", "job/1", 200, RestResponse{NumRecords: 1, Records: []map[string]any{{"status": status, "data": data}}}, nil}
+	}
+	running := job(AnsibleStatusRunning, "running")
+	approve := job(AnsibleStatusRunning, AnsibleJobStatusApprove)
+
+	tests := []struct {
+		name       string
+		responses  []MockResponse
+		options    JobWaitOptions
+		wantStatus string
+		wantErr    string
+	}{
+		{
+			name:       "success",
+			responses:  []MockResponse{running, job(AnsibleStatusSuccess, "success")},
+			wantStatus: AnsibleStatusSuccess,
+		},
+		{
+			name:       "failure",
+			responses:  []MockResponse{job(AnsibleStatusFailure, "failed")},
+			wantStatus: AnsibleStatusFailure,
+			wantErr:    "Ansible returned failure",
+		},
+		{
+			name:       "timeout",
+			responses:  []MockResponse{running},
+			options:    JobWaitOptions{Timeout: time.Nanosecond},
+			wantStatus: AnsibleStatusRunning,
+			wantErr:    "loop timed-out [running time was longer than 1ns]",
+		},
+		{
+			name:       "pending approval not waited for",
+			responses:  []MockResponse{approve},
+			wantStatus: AnsibleJobStatusApprove,
+		},
+		{
+			name:       "fail on pending approval",
+			responses:  []MockResponse{approve},
+			options:    JobWaitOptions{FailOnPendingApproval: true, ApprovalTimeout: time.Hour},
+			wantStatus: AnsibleJobStatusApprove,
+			wantErr:    "job 1 is pending approval by roles admin",
+		},
+		{
+			name:       "approval timeout",
+			responses:  []MockResponse{approve, approve, approve},
+			options:    JobWaitOptions{ApprovalTimeout: time.Nanosecond},
+			wantStatus: AnsibleJobStatusApprove,
+			wantErr:    "when waiting for approval of job 1 by roles admin, loop timed-out",
+		},
+		{
+			name:       "approved then success",
+			responses:  []MockResponse{approve, running, job(AnsibleStatusSuccess, "success")},
+			options:    JobWaitOptions{ApprovalTimeout: time.Hour},
+			wantStatus: AnsibleStatusSuccess,
+		},
+		{
+			name:       "rejected",
+			responses:  []MockResponse{approve, job(AnsibleStatusSuccess, AnsibleJobStatusRejected)},
+			options:    JobWaitOptions{ApprovalTimeout: time.Hour},
+			wantStatus: AnsibleJobStatusRejected,
+			wantErr:    "job 1 was rejected",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewMockedRestClient(tt.responses)
+			if err != nil {
+				t.Fatal(err)
+			}
+			status, _, err := c.WaitForJob(1, tt.options)
+			if status != tt.wantStatus {
+				t.Errorf("RestClient.WaitForJob() status = %q, want %q", status, tt.wantStatus)
+			}
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("RestClient.WaitForJob() error = %v, want none", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("RestClient.WaitForJob() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -49,11 +49,13 @@ func (r *RestClient) unmarshalResponse(statusCode int, responseJSON []byte, http
 	// We don't know which fields are present or not, and fields may not be in a record, so just use any
 	var dataMap map[string]any
 	if err := json.Unmarshal(responseJSON, &dataMap); err != nil {
-		tflog.Error(r.ctx, fmt.Sprintf("unable to unmarshall response, this may be expected when statusCode %d >= 300, unmarshall error=%s, response=%#v", statusCode, err, responseJSON))
+		tflog.Error(r.ctx, fmt.Sprintf("unable to unmarshall response, this may be expected when statusCode %d >= 300, unmarshall error=%s, response=%s", statusCode, err, r.logBody(string(responseJSON))))
 		emptyResponse.ErrorType = "bad_response_decode_json"
 		return statusCode, emptyResponse, err
 	}
-	tflog.Debug(r.ctx, fmt.Sprintf("dataMap %#v", dataMap))
+	if r.connectionProfile.LogBodies {
+		tflog.Debug(r.ctx, fmt.Sprintf("dataMap %#v", dataMap))
+	}
 
 	// The returned REST response may or may not contain records.
 	// If records is not present, the contents will show in Other.
@@ -69,12 +71,14 @@ func (r *RestClient) unmarshalResponse(statusCode int, responseJSON []byte, http
 	var rawResponse restStagedResponse
 	var metadata mapstructure.Metadata
 	if err := mapstructure.DecodeMetadata(dataMap, &rawResponse, &metadata); err != nil {
-		tflog.Error(r.ctx, fmt.Sprintf("unable to format raw response, this may be expected when statusCode %d >= 300, unmarshall error=%s, response=%#v", statusCode, err, dataMap))
+		tflog.Error(r.ctx, fmt.Sprintf("unable to format raw response, this may be expected when statusCode %d >= 300, unmarshall error=%s, response=%s", statusCode, err, r.logBody(dataMap)))
 		emptyResponse.ErrorType = "bad_response_decode_interface"
 		return statusCode, emptyResponse, err
 	}
 
-	tflog.Debug(r.ctx, fmt.Sprintf("rawResponse %#v, metadata %#v", rawResponse, metadata))
+	if r.connectionProfile.LogBodies {
+		tflog.Debug(r.ctx, fmt.Sprintf("rawResponse %#v, metadata %#v", rawResponse, metadata))
+	}
 
 	// If Other is present, add it to records.
 	// But ignore it if we already have some records.
@@ -89,7 +93,7 @@ func (r *RestClient) unmarshalResponse(statusCode int, responseJSON []byte, http
 
 	var finalResponse RestResponse
 	if err := mapstructure.DecodeMetadata(rawResponse, &finalResponse, &metadata); err != nil {
-		tflog.Error(r.ctx, fmt.Sprintf("unable to format final response - statusCode %d, http err=%#v, decode error=%s, response=%#v", statusCode, httpClientErr, err, rawResponse))
+		tflog.Error(r.ctx, fmt.Sprintf("unable to format final response - statusCode %d, http err=%#v, decode error=%s, response=%s", statusCode, httpClientErr, err, r.logBody(rawResponse)))
 		emptyResponse.ErrorType = "bad_response_decode_raw"
 		return statusCode, emptyResponse, err
 	}
@@ -97,11 +101,21 @@ func (r *RestClient) unmarshalResponse(statusCode int, responseJSON []byte, http
 	// If we reached this point, the only possible errors are a bad HTTP status code and/or a REST error encoded in the paybload
 	finalResponse.StatusCode = statusCode
 	finalResponse, err := r.checkRestErrors(statusCode, finalResponse)
-	tflog.Debug(r.ctx, fmt.Sprintf("finalResponse %#v, metadata %#v", finalResponse, metadata))
+	if r.connectionProfile.LogBodies {
+		tflog.Debug(r.ctx, fmt.Sprintf("finalResponse %#v, metadata %#v", finalResponse, metadata))
+	}
 
 	return statusCode, finalResponse, err
 }
 
+// logBody formats a response for the logs, the response is only logged when LogBodies is set as it may hold secrets.
+func (r *RestClient) logBody(response any) string {
+	if !r.connectionProfile.LogBodies {
+		return "(not logged, set log_bodies to log it)"
+	}
+	return fmt.Sprintf("%#v", response)
+}
+
 // check for statusCode and RestError
 func (r *RestClient) checkRestErrors(statusCode int, response RestResponse) (RestResponse, error) {
 	var err error
@@ -112,7 +126,7 @@ func (r *RestClient) checkRestErrors(statusCode int, response RestResponse) (Res
 		response.ErrorType = "statuscode_error"
 	}
 	if err != nil {
-		tflog.Error(r.ctx, fmt.Sprintf("checkRestError: %s, statusCode %d, response: %#v", err, statusCode, response))
+		tflog.Error(r.ctx, fmt.Sprintf("checkRestError: %s, statusCode %d, response: %s", err, statusCode, r.logBody(response)))
 	}
 
 	return response, err
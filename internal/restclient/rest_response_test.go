@@ -1,13 +1,16 @@
 package restclient
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -124,3 +127,32 @@ func TestRestClient_unmarshalResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestRestClient_unmarshalResponse_logBodies(t *testing.T) {
+	tests := []struct {
+		name      string
+		logBodies bool
+		response  string
+		want      bool
+	}{
+		{name: "invalid JSON", response: `not json "s3cret"`, want: false},
+		{name: "invalid JSON with log_bodies", logBodies: true, response: `not json "s3cret"`, want: true},
+		{name: "REST error", response: `{"error": {"code": "1", "message": "failed"}, "value": "s3cret"}`, want: false},
+		{name: "REST error with log_bodies", logBodies: true, response: `{"error": {"code": "1", "message": "failed"}, "value": "s3cret"}`, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			r := RestClient{
+				connectionProfile: ConnectionProfile{LogBodies: tt.logBodies},
+				ctx:               tflogtest.RootLogger(context.Background(), &logs),
+			}
+			if _, _, err := r.unmarshalResponse(200, []byte(tt.response), nil); err == nil {
+				t.Fatalf("unmarshalResponse() returned no error")
+			}
+			if got := strings.Contains(logs.String(), "s3cret"); got != tt.want {
+				t.Errorf("response logged %v, want %v: %s", got, tt.want, logs.String())
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-ansible-forms/internal/restclient"
 )
 
 // ErrorHandler creates an error handler to combine logging and reporting errors
@@ -18,13 +20,15 @@ type ErrorHandler struct {
 }
 
 // NewErrorHandler creates an error handler based on current context and TF diagnostics
+// Secrets are masked in the logs of the handler context and of the error_handler subsystem.
 func NewErrorHandler(ctx context.Context, diags *diag.Diagnostics) *ErrorHandler {
 	name := "error_handler"
+	ctx = restclient.MaskSecrets(ctx)
 	return &ErrorHandler{
 		Ctx:    ctx,
 		diags:  diags,
 		name:   name,
-		subCtx: tflog.NewSubsystem(ctx, name, tflog.WithAdditionalLocationOffset(1)),
+		subCtx: restclient.MaskSubsystem(ctx, tflog.NewSubsystem(ctx, name, tflog.WithAdditionalLocationOffset(1)), name),
 	}
 }
 
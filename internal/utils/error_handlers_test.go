@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"terraform-provider-ansible-forms/internal/restclient"
+)
+
+func TestNewErrorHandler_masksSecrets(t *testing.T) {
+	var logs bytes.Buffer
+	ctx := restclient.MaskStrings(tflogtest.RootLogger(context.Background(), &logs), "extra-s3cret")
+	var diags diag.Diagnostics
+	errorHandler := NewErrorHandler(ctx, &diags)
+
+	credential := struct {
+		Name     string
+		Password string
+	}{Name: "admin", Password: "s3cret"}
+	tflog.Debug(errorHandler.Ctx, fmt.Sprintf("read credential info: %#v", credential))
+	errorHandler.MakeAndLogError(`error on POST credential: {"password": "s3cret", "value": "extra-s3cret"}`)
+	errorHandler.MakeAndReportError("error creating credential", "statusCode 500, password extra-s3cret")
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatalf("unable to decode logs: %s", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("logged %d entries, want 3", len(entries))
+	}
+	for _, entry := range entries {
+		if message := fmt.Sprintf("%v", entry["@message"]); strings.Contains(message, "s3cret") {
+			t.Errorf("logged %q, want the secrets masked", message)
+		}
+	}
+}